@@ -0,0 +1,123 @@
+package apperror
+
+import "errors"
+
+// Code Machine readable category of an error, stable across releases so clients can react to
+// it without matching messages.
+type Code int
+
+const (
+	// Internal Unexpected failure of the service.
+	Internal Code = iota
+	// NotFound The requested author doesn't exist.
+	NotFound
+	// AlreadyExists The author being created is already registered.
+	AlreadyExists
+	// InvalidArgument The request is malformed or has invalid values.
+	InvalidArgument
+	// Unavailable A dependency of the service can't be reached. Retrying later may succeed.
+	Unavailable
+	// Conflict The author was changed by someone else since the version the request expected.
+	Conflict
+)
+
+// codeNames Names used when serializing the codes.
+var codeNames = map[Code]string{
+	Internal:        "INTERNAL",
+	NotFound:        "NOT_FOUND",
+	AlreadyExists:   "ALREADY_EXISTS",
+	InvalidArgument: "INVALID_ARGUMENT",
+	Unavailable:     "UNAVAILABLE",
+	Conflict:        "CONFLICT",
+}
+
+// String Returns the serialized name of the code.
+func (code Code) String() string {
+	name, ok := codeNames[code]
+	if !ok {
+		return codeNames[Internal]
+	}
+	return name
+}
+
+// FieldViolation Describes why the value of a single field is not valid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error Error carrying a Code and a human readable message. The cause, when set, is kept for
+// logging and errors.Is checks but is not part of the message sent to clients.
+type Error struct {
+	Code       Code
+	Message    string
+	Err        error
+	Violations []FieldViolation
+}
+
+// New Creates a new Error with the code and message.
+func New(code Code, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// Wrap Creates a new Error with the code and message caused by err.
+func Wrap(code Code, message string, err error) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// Invalid Creates a new InvalidArgument Error listing the fields that are not valid.
+func Invalid(message string, violations []FieldViolation) *Error {
+	return &Error{
+		Code:       InvalidArgument,
+		Message:    message,
+		Violations: violations,
+	}
+}
+
+// Error Returns the message followed by the cause, if any.
+func (err *Error) Error() string {
+	if err.Err == nil {
+		return err.Message
+	}
+	return err.Message + ": " + err.Err.Error()
+}
+
+// Unwrap Returns the cause of the error.
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+// CodeOf Returns the Code of the first Error found on the chain of err. Errors without a code
+// are considered Internal.
+func CodeOf(err error) Code {
+	var appError *Error
+	if errors.As(err, &appError) {
+		return appError.Code
+	}
+	return Internal
+}
+
+// MessageOf Returns the human readable message of err to be sent to clients.
+func MessageOf(err error) string {
+	var appError *Error
+	if errors.As(err, &appError) {
+		return appError.Message
+	}
+	return err.Error()
+}
+
+// ViolationsOf Returns the field violations of the first Error found on the chain of err.
+func ViolationsOf(err error) []FieldViolation {
+	var appError *Error
+	if errors.As(err, &appError) {
+		return appError.Violations
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCodeString(t *testing.T) {
+	assert.Equal(t, "NOT_FOUND", NotFound.String())
+	assert.Equal(t, "INVALID_ARGUMENT", InvalidArgument.String())
+	assert.Equal(t, "CONFLICT", Conflict.String())
+	assert.Equal(t, "INTERNAL", Code(100).String())
+}
+
+func TestCodeOf(t *testing.T) {
+	notFound := New(NotFound, "author not found")
+	assert.Equal(t, NotFound, CodeOf(notFound))
+	assert.Equal(t, NotFound, CodeOf(fmt.Errorf("reading: %w", notFound)))
+	assert.Equal(t, Internal, CodeOf(errors.New("plain error")))
+}
+
+func TestMessageOf(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := Wrap(Unavailable, "database unavailable", cause)
+	assert.Equal(t, "database unavailable", MessageOf(err))
+	assert.Equal(t, "database unavailable: connection refused", err.Error())
+	assert.Equal(t, "plain error", MessageOf(errors.New("plain error")))
+}
+
+func TestWrapKeepsCause(t *testing.T) {
+	cause := errors.New("cause")
+	err := Wrap(Internal, "failure", cause)
+	assert.ErrorIs(t, err, cause)
+}
+
+func TestViolationsOf(t *testing.T) {
+	violations := []FieldViolation{{Field: "name", Description: "name is required"}}
+	err := fmt.Errorf("creating: %w", Invalid("invalid author", violations))
+	assert.Equal(t, InvalidArgument, CodeOf(err))
+	assert.Equal(t, violations, ViolationsOf(err))
+	assert.Nil(t, ViolationsOf(errors.New("plain error")))
+}
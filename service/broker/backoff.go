@@ -0,0 +1,43 @@
+package broker
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff Exponential backoff with jitter, spacing the reconnection attempts so a restarting broker
+// isn't flooded by every service at once.
+type Backoff struct {
+	// Initial Delay before the first attempt.
+	Initial time.Duration
+	// Max Maximum delay between attempts.
+	Max time.Duration
+	// Multiplier Factor the delay grows by on every attempt.
+	Multiplier float64
+	// Jitter Fraction of the delay, between 0 and 1, randomly taken off each one.
+	Jitter float64
+}
+
+// DefaultBackoff Returns the Backoff used by default, starting at half a second and growing up
+// to 30 seconds.
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Initial:    500 * time.Millisecond,
+		Max:        30 * time.Second,
+		Multiplier: 2,
+		Jitter:     0.5,
+	}
+}
+
+// Delay Returns the time to wait before the attempt, counting from 1.
+func (backoff Backoff) Delay(attempt int) time.Duration {
+	delay := float64(backoff.Initial)
+	for i := 1; i < attempt && delay < float64(backoff.Max); i++ {
+		delay *= backoff.Multiplier
+	}
+	if delay > float64(backoff.Max) {
+		delay = float64(backoff.Max)
+	}
+	delay -= delay * backoff.Jitter * rand.Float64()
+	return time.Duration(delay)
+}
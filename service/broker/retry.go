@@ -0,0 +1,47 @@
+package broker
+
+import "github.com/streadway/amqp"
+
+// AttemptsHeader Header counting the times a message was processed, set on the messages sent to be
+// retried and to the dead letter exchange.
+const AttemptsHeader = "x-attempts"
+
+// Attempts Returns how many times the message was already processed, as recorded on its
+// AttemptsHeader or, for messages retried by the broker dead lettering them, counted from the
+// times they were rejected from the queue.
+func Attempts(headers amqp.Table, queue string) int64 {
+	if attempts, ok := intValue(headers[AttemptsHeader]); ok {
+		return attempts
+	}
+	return Deaths(headers, queue, "rejected")
+}
+
+// Deaths Returns how many times the message was dead lettered from the queue for the reason, as
+// counted by the broker on its x-death header.
+func Deaths(headers amqp.Table, queue string, reason string) int64 {
+	deaths, _ := headers["x-death"].([]interface{})
+	for _, death := range deaths {
+		table, ok := death.(amqp.Table)
+		if !ok || table["queue"] != queue || table["reason"] != reason {
+			continue
+		}
+		count, _ := intValue(table["count"])
+		return count
+	}
+	return 0
+}
+
+// intValue Returns the value of an integer header, whatever its size.
+func intValue(value interface{}) (int64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return value, true
+	case int32:
+		return int64(value), true
+	case int16:
+		return int64(value), true
+	case int8:
+		return int64(value), true
+	}
+	return 0, false
+}
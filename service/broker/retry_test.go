@@ -0,0 +1,33 @@
+package broker
+
+import (
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeaths(t *testing.T) {
+	headers := amqp.Table{
+		"x-death": []interface{}{
+			amqp.Table{"queue": "authorQueue.retry", "reason": "expired", "count": int64(2)},
+			amqp.Table{"queue": "authorQueue", "reason": "rejected", "count": int64(3)},
+		},
+	}
+	assert.Equal(t, int64(3), Deaths(headers, "authorQueue", "rejected"))
+	assert.Equal(t, int64(2), Deaths(headers, "authorQueue.retry", "expired"))
+	assert.Zero(t, Deaths(headers, "authorQueue", "expired"))
+	assert.Zero(t, Deaths(amqp.Table{}, "authorQueue", "rejected"))
+	assert.Zero(t, Deaths(nil, "authorQueue", "rejected"))
+	assert.Equal(t, int64(1), Deaths(amqp.Table{
+		"x-death": []interface{}{amqp.Table{"queue": "authorQueue", "reason": "rejected", "count": int32(1)}},
+	}, "authorQueue", "rejected"))
+}
+
+func TestAttempts(t *testing.T) {
+	rejected := []interface{}{amqp.Table{"queue": "authorQueue", "reason": "rejected", "count": int64(2)}}
+	assert.Equal(t, int64(3), Attempts(amqp.Table{AttemptsHeader: int64(3), "x-death": rejected}, "authorQueue"))
+	assert.Equal(t, int64(1), Attempts(amqp.Table{AttemptsHeader: int32(1)}, "authorQueue"))
+	assert.Equal(t, int64(2), Attempts(amqp.Table{"x-death": rejected}, "authorQueue"),
+		"messages retried by dead lettering count the rejections")
+	assert.Zero(t, Attempts(nil, "authorQueue"))
+}
@@ -0,0 +1,169 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// State State of the connection to the broker.
+type State int32
+
+const (
+	// Disconnected There is no connection to the broker.
+	Disconnected State = iota
+	// Connecting The connection to the broker is being established.
+	Connecting
+	// Connected The connection to the broker is up.
+	Connected
+)
+
+// String Returns the name of the state.
+func (state State) String() string {
+	switch state {
+	case Connecting:
+		return "connecting"
+	case Connected:
+		return "connected"
+	}
+	return "disconnected"
+}
+
+// Session Serves a connection to the broker until it is closed or ctx is done. Returning closes
+// the connection.
+type Session func(ctx context.Context, connection *amqp.Connection) error
+
+// Supervisor Keeps a connection to the broker, reconnecting with backoff whenever it is lost, and
+// serves a Session on each connection.
+type Supervisor struct {
+	address string
+	backoff Backoff
+	dial    func(address string) (*amqp.Connection, error)
+	logger  *slog.Logger
+
+	state      int32
+	reconnects uint64
+	mutex      sync.Mutex
+	connection *amqp.Connection
+}
+
+// NewSupervisor Creates a new Supervisor connecting to the broker at address, waiting between
+// failed attempts as set by backoff, and logging the changes of the connection to logger.
+func NewSupervisor(address string, backoff Backoff, logger *slog.Logger) *Supervisor {
+	return &Supervisor{
+		address: address,
+		backoff: backoff,
+		dial:    amqp.Dial,
+		logger:  logger,
+	}
+}
+
+// State Returns the current state of the connection, for health checks.
+func (supervisor *Supervisor) State() State {
+	return State(atomic.LoadInt32(&supervisor.state))
+}
+
+// Reconnects Returns the times the connection was established again after being lost.
+func (supervisor *Supervisor) Reconnects() uint64 {
+	return atomic.LoadUint64(&supervisor.reconnects)
+}
+
+// setState Changes the state of the connection, logging the change.
+func (supervisor *Supervisor) setState(state State) {
+	if State(atomic.SwapInt32(&supervisor.state, int32(state))) != state {
+		supervisor.logger.Info("RabbitMQ connection changed", "state", state)
+	}
+}
+
+// Run Connects to the broker and serves the session on the connection, reconnecting whenever it is
+// lost, until ctx is done. Run returns once the last session returned.
+func (supervisor *Supervisor) Run(ctx context.Context, session Session) {
+	defer supervisor.setState(Disconnected)
+	lost := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && !sleep(ctx, supervisor.backoff.Delay(attempt)) {
+			return
+		}
+		supervisor.setState(Connecting)
+		connection, err := supervisor.dial(supervisor.address)
+		if err != nil {
+			supervisor.logger.WarnContext(ctx, "Failed to connect to RabbitMQ", "error", err)
+			continue
+		}
+		if lost {
+			atomic.AddUint64(&supervisor.reconnects, 1)
+		}
+		supervisor.setState(Connected)
+		err = supervisor.serve(ctx, connection, session)
+		if ctx.Err() != nil {
+			return
+		}
+		supervisor.logger.WarnContext(ctx, "Lost the RabbitMQ connection", "error", err)
+		supervisor.setState(Disconnected)
+		lost = true
+		attempt = 0
+	}
+}
+
+// serve Serves the session on the connection until either returns, then closes the connection.
+// Returns the reason the connection was lost.
+func (supervisor *Supervisor) serve(ctx context.Context, connection *amqp.Connection, session Session) error {
+	supervisor.mutex.Lock()
+	supervisor.connection = connection
+	supervisor.mutex.Unlock()
+	defer func() {
+		supervisor.mutex.Lock()
+		supervisor.connection = nil
+		supervisor.mutex.Unlock()
+		if err := connection.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+			supervisor.logger.WarnContext(ctx, "Failed to close the RabbitMQ connection", "error", err)
+		}
+	}()
+	closed := connection.NotifyClose(make(chan *amqp.Error, 1))
+	served := make(chan error, 1)
+	go func() {
+		served <- session(ctx, connection)
+	}()
+	select {
+	case reason := <-closed:
+		// The session returns on its own once the channels of the connection are closed.
+		<-served
+		if reason == nil {
+			return amqp.ErrClosed
+		}
+		return reason
+	case err := <-served:
+		if err == nil {
+			err = errors.New("session ended")
+		}
+		return err
+	}
+}
+
+// Close Closes the current connection, making its session return. Used to abort a session that
+// doesn't return in time after ctx is done.
+func (supervisor *Supervisor) Close() error {
+	supervisor.mutex.Lock()
+	defer supervisor.mutex.Unlock()
+	if supervisor.connection == nil {
+		return nil
+	}
+	return supervisor.connection.Close()
+}
+
+// sleep Waits for the delay, returning false when ctx is done first.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,51 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestState_String(t *testing.T) {
+	assert.Equal(t, "disconnected", Disconnected.String())
+	assert.Equal(t, "connecting", Connecting.String())
+	assert.Equal(t, "connected", Connected.String())
+}
+
+func TestSupervisor_RetriesUntilCancelled(t *testing.T) {
+	supervisor := NewSupervisor("amqp://localhost", Backoff{Initial: time.Millisecond, Max: time.Millisecond}, slog.Default())
+	var attempts int32
+	supervisor.dial = func(address string) (*amqp.Connection, error) {
+		assert.Equal(t, "amqp://localhost", address)
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("connection refused")
+	}
+	assert.Equal(t, Disconnected, supervisor.State())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		supervisor.Run(ctx, func(context.Context, *amqp.Connection) error {
+			t.Error("session served without a connection")
+			return nil
+		})
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&attempts) >= 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, Connecting, supervisor.State())
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("supervisor didn't stop after the context was cancelled")
+	}
+	assert.Equal(t, Disconnected, supervisor.State())
+	assert.NoError(t, supervisor.Close())
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// fileFlag Flag setting the path of the YAML file, also set through the
+	// AUTHOR_SERVICE_CONFIG_FILE variable.
+	fileFlag = "config"
+	// EnvPrefix Prefix of the environment variables read, so they don't clash with the ones set by
+	// the platform, as the <SERVICE>_PORT variables Kubernetes sets for every service.
+	EnvPrefix = "AUTHOR_SERVICE_"
+)
+
+// Load Loads the settings from, in increasing precedence: their defaults, the YAML file set by the
+// -config flag or the AUTHOR_SERVICE_CONFIG_FILE variable, the environment variables named as the
+// settings in upper case after EnvPrefix, as AUTHOR_SERVICE_QUEUE_NAME, and the command line args.
+// The secrets, rabbitmq_address and db_connector, can also be read from a file, as Docker and
+// Kubernetes secrets, setting its path on AUTHOR_SERVICE_RABBITMQ_ADDRESS_FILE or
+// AUTHOR_SERVICE_DB_CONNECTOR_FILE, or the rabbitmq_address_file and db_connector_file keys of the
+// YAML file. The loaded Config is validated. lookupEnv is usually os.LookupEnv.
+func Load(name string, args []string, lookupEnv func(string) (string, bool), output io.Writer) (*Config, error) {
+	config := &Config{}
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(output)
+	bind(flags, config)
+	file := flags.String(fileFlag, "", "YAML file the settings are read from. Defaults to "+
+		EnvPrefix+"CONFIG_FILE.")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if *file == "" {
+		*file, _ = lookupEnv(EnvPrefix + "CONFIG_FILE")
+	}
+	if *file != "" {
+		if err := loadFile(flags, *file); err != nil {
+			return nil, err
+		}
+	}
+	warnings, err := loadEnv(flags, lookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	config.Warnings = warnings
+	// Parsed again, as the command line takes precedence over the file and the environment.
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadFile Sets the settings found on the YAML file at path. Lists are joined with commas.
+func loadFile(flags *flag.FlagSet, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read the config file: %w", err)
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return fmt.Errorf("failed to parse the config file %s: %w", path, err)
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := fileValue(values[key])
+		if secret := strings.TrimSuffix(key, "_file"); secret != key && secrets[secret] {
+			if value, err = readSecret(value); err != nil {
+				return fmt.Errorf("failed to read %s: %w", key, err)
+			}
+			key = secret
+		}
+		if key == fileFlag || flags.Lookup(key) == nil {
+			return fmt.Errorf("unknown setting %s on the config file %s", key, path)
+		}
+		if err := flags.Set(key, value); err != nil {
+			return fmt.Errorf("invalid %s on the config file %s: %w", key, path, err)
+		}
+	}
+	return nil
+}
+
+// fileValue Returns the value of a YAML key as the flags take it.
+func fileValue(value interface{}) string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	items := make([]string, len(list))
+	for i, item := range list {
+		items[i] = fmt.Sprint(item)
+	}
+	return strings.Join(items, ",")
+}
+
+// loadEnv Sets the settings whose environment variables, named after EnvPrefix, are set, reading
+// the secrets from the files set on their _FILE variables. The secrets are also read from the
+// deprecated variables without prefix used by earlier versions, as RABBITMQ_ADDRESS, when the
+// prefixed ones aren't set, returning a warning for each one read.
+func loadEnv(flags *flag.FlagSet, lookupEnv func(string) (string, bool)) ([]string, error) {
+	var err error
+	var warnings []string
+	flags.VisitAll(func(setting *flag.Flag) {
+		if err != nil || setting.Name == fileFlag {
+			return
+		}
+		variable := EnvPrefix + strings.ToUpper(setting.Name)
+		if secrets[setting.Name] {
+			if path, ok := lookupEnv(variable + "_FILE"); ok {
+				value, readErr := readSecret(path)
+				if readErr != nil {
+					err = fmt.Errorf("failed to read %s_FILE: %w", variable, readErr)
+					return
+				}
+				err = setting.Value.Set(value)
+				return
+			}
+		}
+		value, ok := lookupEnv(variable)
+		if !ok && secrets[setting.Name] {
+			deprecated := strings.ToUpper(setting.Name)
+			if value, ok = lookupEnv(deprecated); ok {
+				warnings = append(warnings, fmt.Sprintf("%s is deprecated and will stop being read, set %s instead",
+					deprecated, variable))
+				variable = deprecated
+			}
+		}
+		if ok {
+			if setErr := setting.Value.Set(value); setErr != nil {
+				err = fmt.Errorf("invalid %s: %w", variable, setErr)
+			}
+		}
+	})
+	return warnings, err
+}
+
+// readSecret Reads a secret from the file at path, without its surrounding whitespace.
+func readSecret(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"service/metadata"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditOperation Operation that changed an author, recorded on the audit log.
+type AuditOperation string
+
+const (
+	// AuditCreate The author was added.
+	AuditCreate AuditOperation = "CREATE"
+	// AuditUpdate The author was updated.
+	AuditUpdate AuditOperation = "UPDATE"
+	// AuditDelete The author was soft deleted.
+	AuditDelete AuditOperation = "DELETE"
+	// AuditRestore The soft deleted author was restored.
+	AuditRestore AuditOperation = "RESTORE"
+	// AuditPurge The soft deleted author was permanently deleted.
+	AuditPurge AuditOperation = "PURGE"
+)
+
+// AuditEntry Append only record of a change made to an author, written on the same transaction
+// as the change.
+type AuditEntry struct {
+	// ID Position of the entry on the audit log, increasing with every change.
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	AuthorID  uuid.UUID `gorm:"index"`
+	Operation AuditOperation
+	// Before State of the author before the change, nil for creations.
+	Before *Author `gorm:"serializer:json"`
+	// After State of the author after the change, nil for purges.
+	After *Author `gorm:"serializer:json"`
+	// CorrelationID Correlation id of the request that made the change.
+	CorrelationID string
+	// Actor Actor that made the change.
+	Actor     string
+	CreatedAt time.Time
+}
+
+// newAuditEntry Creates the AuditEntry recording the change of the author, taking the
+// correlation id and actor from ctx.
+func newAuditEntry(ctx context.Context, operation AuditOperation, id uuid.UUID, before *Author, after *Author) *AuditEntry {
+	return &AuditEntry{
+		AuthorID:      id,
+		Operation:     operation,
+		Before:        before,
+		After:         after,
+		CorrelationID: metadata.CorrelationIDFrom(ctx),
+		Actor:         metadata.ActorFrom(ctx),
+		CreatedAt:     now(),
+	}
+}
@@ -1,104 +1,399 @@
 package database
 
 import (
+	"context"
+	"database/sql/driver"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
 	"gorm.io/gorm"
+	"log/slog"
+	"net"
+	"service/apperror"
+	"strings"
+	"time"
 )
 
 // DbConnector connector used on the service.
 type DbConnector struct {
 	Database *gorm.DB
+	logger   *slog.Logger
 }
 
+// ConnectionOption Customizes the DbConnector created by NewConnection.
+type ConnectionOption func(database *DbConnector)
+
+// WithLogger Sets the logger of the connector, also used for the queries run by gorm. When not
+// set slog.Default is used.
+func WithLogger(logger *slog.Logger) ConnectionOption {
+	return func(database *DbConnector) {
+		database.logger = logger
+	}
+}
+
+var _ AuthorStore = (*DbConnector)(nil)
+
 // Author type that will be stored in the DbConnector.
 type Author struct {
 	ID     *uuid.UUID `gorm:"primaryKey,unique,default:uuid_generate_v4()"`
 	Name   string
 	PicURL *string
+	// Version Incremented on every update, used to detect concurrent changes.
+	Version uint64 `gorm:"not null;default:1"`
+	// CreatedAt Time the author was added, set by the store.
+	CreatedAt time.Time `gorm:"index"`
+	// UpdatedAt Time of the last change of the author, set by the store.
+	UpdatedAt time.Time `gorm:"index"`
+	// CreatedBy Actor that added the author.
+	CreatedBy string
+	// UpdatedBy Actor that last changed the author.
+	UpdatedBy string
+	// DeletedAt Time the author was soft deleted. Soft deleted authors are skipped by gorm queries
+	// unless they are unscoped.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// now Returns the time used on the author timestamps, truncated to the microsecond precision
+// kept by the databases so stored values compare equal to the ones on page tokens.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
 }
 
 // NewConnection Creates a new in memory DbConnector and automatically migrates the
-// Author model.
-func NewConnection(connector gorm.Dialector) DbConnector {
-	db, err := gorm.Open(connector, &gorm.Config{})
+// Author, AuditEntry, OutboxMessage and ProcessedMessage models.
+func NewConnection(connector gorm.Dialector, options ...ConnectionOption) *DbConnector {
+	database := &DbConnector{logger: slog.Default()}
+	for _, option := range options {
+		option(database)
+	}
+	db, err := gorm.Open(connector, &gorm.Config{Logger: queryLogger{logger: database.logger}})
 	if err != nil {
 		panic("Failed to connect to database.")
 	}
-	err = db.AutoMigrate(&Author{})
+	database.logger.Info("Migrating the database")
+	err = db.AutoMigrate(&Author{}, &AuditEntry{}, &OutboxMessage{}, &ProcessedMessage{})
 	if err != nil {
 		panic("Failed to migrate to database.")
 	}
-	return DbConnector{
-		Database: db,
-	}
+	database.Database = db
+	return database
 }
 
-// uuidParseOrCreate Parse the string ID into a UUID or creates a new one when the passed value
-// is invalid.
-func uuidParseOrCreate(id string) uuid.UUID {
+// parseUUID Parse the string ID into a UUID, returning ErrInvalidUUID when it is malformed.
+func parseUUID(id string) (uuid.UUID, error) {
 	value, err := uuid.Parse(id)
-	if err == nil {
-		return value
+	if err != nil {
+		return uuid.UUID{}, ErrInvalidUUID
 	}
-	return uuid.New()
+	return value, nil
 }
 
 // CloseDatabase Closes that database that was open when creating a new database using the
 // NewConnection method.
-func (database *DbConnector) CloseDatabase() {
-	db, _ := database.Database.DB()
-	defer db.Close()
+func (database *DbConnector) CloseDatabase() error {
+	db, err := database.Database.DB()
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// Ping Checks that the database is reachable.
+func (database *DbConnector) Ping(ctx context.Context) error {
+	db, err := database.Database.DB()
+	if err != nil {
+		return err
+	}
+	return db.PingContext(ctx)
+}
+
+// translateError Converts gorm and driver errors into the typed errors exposed by the
+// AuthorStore, so callers never have to deal with backend specific messages.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var appError *apperror.Error
+	if errors.As(err, &appError) {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrAuthorNotFound
+	}
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) {
+		switch {
+		case pgError.Code == "23505":
+			return apperror.Wrap(apperror.AlreadyExists, ErrAuthorAlreadyExists.Message, err)
+		case strings.HasPrefix(pgError.Code, "22"):
+			return apperror.Wrap(apperror.InvalidArgument, "invalid value", err)
+		case strings.HasPrefix(pgError.Code, "08"), strings.HasPrefix(pgError.Code, "53"),
+			strings.HasPrefix(pgError.Code, "57P"):
+			return apperror.Wrap(apperror.Unavailable, "database unavailable", err)
+		}
+	}
+	var netError net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netError) || pgconn.Timeout(err) {
+		return apperror.Wrap(apperror.Unavailable, "database unavailable", err)
+	}
+	return apperror.Wrap(apperror.Internal, "database error", err)
 }
 
-// AddAuthor Adds an author to the database.
-func (database *DbConnector) AddAuthor(author Author) (*uuid.UUID, error) {
+// AddAuthor Adds an author to the database, recording it on the audit log and the outbox.
+func (database *DbConnector) AddAuthor(ctx context.Context, author Author) (*uuid.UUID, error) {
 	authorToAdd := author
 	if author.ID == nil {
 		newUUID := uuid.New()
-		author.ID = &newUUID
-		authorToAdd = author
+		authorToAdd.ID = &newUUID
+	}
+	err := database.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []Author
+		result := tx.Unscoped().Where("id = ?", authorToAdd.ID).Limit(1).Find(&existing)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return ErrAuthorAlreadyExists
+		}
+		authorToAdd.Version = 1
+		authorToAdd.CreatedAt = now()
+		authorToAdd.UpdatedAt = authorToAdd.CreatedAt
+		if err := tx.Create(&authorToAdd).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, newAuditEntry(ctx, AuditCreate, *authorToAdd.ID, nil, &authorToAdd))
+	})
+	if err != nil {
+		return nil, translateError(err)
 	}
-	result := database.Database.Create(&authorToAdd)
-	return author.ID, result.Error
+	return authorToAdd.ID, nil
 }
 
 // GetAuthor Queries an author on the database using the uuid and return it to the caller.
-func (database *DbConnector) GetAuthor(uuid string) (*Author, error) {
-	var author *Author
-	err := database.Database.First(&author, "id = ?", uuid).Error
+func (database *DbConnector) GetAuthor(ctx context.Context, uuid string) (*Author, error) {
+	id, err := parseUUID(uuid)
 	if err != nil {
 		return nil, err
 	}
+	var author *Author
+	err = database.Database.WithContext(ctx).First(&author, "id = ?", id).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
 	return author, nil
 }
 
-// GetAuthors Gets all authors on the database.
-func (database *DbConnector) GetAuthors() []Author {
-	var allAuthors []Author
-	database.Database.Find(&allAuthors)
-	return allAuthors
+// timeColumns Maps the orders by timestamp into the columns holding them.
+var timeColumns = map[Order]string{
+	OrderByCreatedAt: "created_at",
+	OrderByUpdatedAt: "updated_at",
+}
+
+// ListAuthors Gets one page of authors from the database using keyset pagination, so
+// fetching any page costs the same independently of how deep it is.
+func (database *DbConnector) ListAuthors(ctx context.Context, options ListOptions) (AuthorPage, error) {
+	cursor, err := options.cursor()
+	if err != nil {
+		return AuthorPage{}, err
+	}
+	query := database.Database.WithContext(ctx)
+	if options.IncludeDeleted {
+		query = query.Unscoped()
+	}
+	if options.NamePrefix != "" {
+		query = query.Where(`LOWER(name) LIKE ? ESCAPE '\'`, escapeLike(options.NamePrefix)+"%")
+	}
+	if options.NameContains != "" {
+		query = query.Where(`LOWER(name) LIKE ? ESCAPE '\'`, "%"+escapeLike(options.NameContains)+"%")
+	}
+	switch options.OrderBy {
+	case OrderByName:
+		if cursor != nil {
+			query = query.Where("(name > ? OR (name = ? AND id > ?))", cursor.Name, cursor.Name, cursor.ID)
+		}
+		query = query.Order("name").Order("id")
+	case OrderByCreatedAt, OrderByUpdatedAt:
+		column := timeColumns[options.OrderBy]
+		if cursor != nil {
+			query = query.Where("("+column+" > ? OR ("+column+" = ? AND id > ?))", *cursor.Time, *cursor.Time, cursor.ID)
+		}
+		query = query.Order(column).Order("id")
+	default:
+		if cursor != nil {
+			query = query.Where("id > ?", cursor.ID)
+		}
+		query = query.Order("id")
+	}
+	var authors []Author
+	err = query.Limit(options.limit() + 1).Find(&authors).Error
+	if err != nil {
+		return AuthorPage{}, translateError(err)
+	}
+	return buildPage(authors, options), nil
+}
+
+// columns Maps the fields of an update to the columns storing them.
+var columns = map[Field]string{
+	FieldName:   "name",
+	FieldPicURL: "pic_url",
 }
 
-// UpdateAuthor Updates the author entry with the new name and picUrl.
-func (database *DbConnector) UpdateAuthor(author Author) error {
+// values Returns the column values written by an update. When the options set the fields to
+// update only those columns are written, including empty values, otherwise the empty ones are
+// skipped. The update metadata is always written.
+func values(author Author, options UpdateOptions) map[string]interface{} {
+	written := map[string]interface{}{
+		"updated_at": now(),
+		"updated_by": author.UpdatedBy,
+	}
+	if options.Has(FieldName) || (len(options.Fields) == 0 && author.Name != "") {
+		written[columns[FieldName]] = author.Name
+	}
+	if options.Has(FieldPicURL) || (len(options.Fields) == 0 && author.PicURL != nil) {
+		written[columns[FieldPicURL]] = author.PicURL
+	}
+	return written
+}
+
+// UpdateAuthor Updates the author entry with the new name and picUrl, incrementing its version.
+func (database *DbConnector) UpdateAuthor(ctx context.Context, author Author, options UpdateOptions) error {
 	if author.ID == nil {
-		return errors.New("can´t update author without proper id")
+		return ErrMissingID
 	}
-	var found, err = database.GetAuthor(author.ID.String())
-	if err != nil || found == nil {
+	return database.change(ctx, author.ID.String(), options.ExpectedVersion, AuditUpdate,
+		func(tx *gorm.DB, before Author) *gorm.DB {
+			written := values(author, options)
+			written["version"] = gorm.Expr("version + 1")
+			return tx.Model(&Author{}).Where("id = ? AND version = ?", before.ID, before.Version).Updates(written)
+		})
+}
+
+// DeleteAuthor Soft deletes an author from the database with registered to the passed uuid.
+func (database *DbConnector) DeleteAuthor(ctx context.Context, uuid string, options DeleteOptions) error {
+	return database.change(ctx, uuid, options.ExpectedVersion, AuditDelete,
+		func(tx *gorm.DB, before Author) *gorm.DB {
+			return tx.Where("id = ? AND version = ?", before.ID, before.Version).Delete(&Author{})
+		})
+}
+
+// maxChangeAttempts Number of times a change is attempted while the author keeps being changed
+// concurrently.
+const maxChangeAttempts = 3
+
+// errConcurrentChange Returned by the transaction of a change when the author was changed
+// between being read and being written.
+var errConcurrentChange = errors.New("author changed concurrently")
+
+// change Applies a change to the active author registered with the uuid and records it on the
+// audit log and the outbox, on a single transaction. apply must only change the author while it keeps the
+// version it had when read, so concurrent changes are detected: they fail with
+// ErrVersionConflict when a version was expected and are retried otherwise.
+func (database *DbConnector) change(ctx context.Context, uuid string, expectedVersion uint64, operation AuditOperation,
+	apply func(tx *gorm.DB, before Author) *gorm.DB) error {
+	id, err := parseUUID(uuid)
+	if err != nil {
 		return err
 	}
-	err = database.Database.Model(author).Updates(author).Error
-	return err
+	for attempt := 1; ; attempt++ {
+		err = database.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var before Author
+			if err := tx.Where("id = ?", id).First(&before).Error; err != nil {
+				return err
+			}
+			if expectedVersion != 0 && expectedVersion != before.Version {
+				return ErrVersionConflict
+			}
+			result := apply(tx, before)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return errConcurrentChange
+			}
+			var after Author
+			if err := tx.Unscoped().Where("id = ?", id).First(&after).Error; err != nil {
+				return err
+			}
+			return recordChange(tx, newAuditEntry(ctx, operation, id, &before, &after))
+		})
+		if !errors.Is(err, errConcurrentChange) {
+			return translateError(err)
+		}
+		if expectedVersion != 0 || attempt == maxChangeAttempts {
+			return ErrVersionConflict
+		}
+	}
 }
 
-// DeleteAuthor Deletes an author from the database with registered to the passed uuid.
-func (database *DbConnector) DeleteAuthor(uuid string) error {
-	var author, err = database.GetAuthor(uuid)
-	if err != nil || author == nil {
+// RestoreAuthor Restores the soft deleted author registered with the passed uuid.
+func (database *DbConnector) RestoreAuthor(ctx context.Context, uuid string) error {
+	id, err := parseUUID(uuid)
+	if err != nil {
 		return err
 	}
-	err = database.Database.Delete(&author).Error
-	return err
+	err = database.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var before Author
+		if err := tx.Unscoped().Where("id = ?", id).First(&before).Error; err != nil {
+			return err
+		}
+		if !before.DeletedAt.Valid {
+			return nil
+		}
+		result := tx.Unscoped().Model(&Author{}).Where("id = ? AND deleted_at IS NOT NULL", id).
+			UpdateColumn("deleted_at", nil)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		after := before
+		after.DeletedAt = gorm.DeletedAt{}
+		return recordChange(tx, newAuditEntry(ctx, AuditRestore, id, &before, &after))
+	})
+	return translateError(err)
+}
+
+// PurgeAuthors Permanently deletes the authors soft deleted before the passed time.
+func (database *DbConnector) PurgeAuthors(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := database.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var authors []Author
+		err := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore.UTC()).
+			Find(&authors).Error
+		if err != nil || len(authors) == 0 {
+			return err
+		}
+		var ids []uuid.UUID
+		var entries []*AuditEntry
+		for i := range authors {
+			ids = append(ids, *authors[i].ID)
+			entries = append(entries, newAuditEntry(ctx, AuditPurge, *authors[i].ID, &authors[i], nil))
+		}
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&Author{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = result.RowsAffected
+		return tx.Create(entries).Error
+	})
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return purged, nil
+}
+
+// GetAuthorHistory Queries the audit log of the author registered with the passed uuid.
+func (database *DbConnector) GetAuthorHistory(ctx context.Context, uuid string) ([]AuditEntry, error) {
+	id, err := parseUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+	var entries []AuditEntry
+	err = database.Database.WithContext(ctx).Where("author_id = ?", id).Order("id").Find(&entries).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if len(entries) == 0 {
+		return nil, ErrAuthorNotFound
+	}
+	return entries, nil
 }
@@ -1,9 +1,16 @@
 package database
 
 import (
+	"context"
+	"database/sql/driver"
+	"errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"net"
+	"service/apperror"
 	"testing"
 )
 
@@ -11,12 +18,13 @@ func TestAddAuthorNotPassingUUID(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author := Author{
 		ID:     nil,
 		Name:   "John Doe",
 		PicURL: nil,
 	}
-	_, err := db.AddAuthor(author)
+	_, err := db.AddAuthor(ctx, author)
 	assert.NoError(t, err, "Fail when adding user.")
 }
 
@@ -24,6 +32,7 @@ func TestAddAuthorPassingUUID(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	newUuid := uuid.New()
 	author := Author{
 		ID:     &newUuid,
@@ -31,7 +40,7 @@ func TestAddAuthorPassingUUID(t *testing.T) {
 		PicURL: nil,
 	}
 	newUUIDString := newUuid.String()
-	id, err := db.AddAuthor(author)
+	id, err := db.AddAuthor(ctx, author)
 	assert.NoError(t, err, "Fail when adding user.")
 	assert.Equal(t, newUUIDString, id.String())
 }
@@ -40,21 +49,22 @@ func TestAddAlreadyExistingAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author := Author{
 		ID:     nil,
 		Name:   "John Doe",
 		PicURL: nil,
 	}
-	id, err := db.AddAuthor(author)
+	id, err := db.AddAuthor(ctx, author)
 	assert.NoError(t, err, "Fail when adding user.")
 	author2 := Author{
 		ID:     id,
 		Name:   "John Doe 2",
 		PicURL: nil,
 	}
-	_, err2 := db.AddAuthor(author2)
-	authors := db.GetAuthors()
-	assert.Error(t, err2, "Author added with same Uuid %s", authors)
+	_, err2 := db.AddAuthor(ctx, author2)
+	page, _ := db.ListAuthors(ctx, ListOptions{})
+	assert.Error(t, err2, "Author added with same Uuid %s", page.Authors)
 
 }
 
@@ -62,16 +72,17 @@ func TestGetAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	picUrl := "johndoe"
 	newAuthor := Author{
 		ID:     nil,
 		Name:   "John Doe",
 		PicURL: &picUrl,
 	}
-	ans, err := db.AddAuthor(newAuthor)
+	ans, err := db.AddAuthor(ctx, newAuthor)
 	assert.NoError(t, err, "Fail when adding user.")
 	uuidString := ans.String()
-	author, errGet := db.GetAuthor(uuidString)
+	author, errGet := db.GetAuthor(ctx, uuidString)
 	assert.NoError(t, errGet, "Fail when retrieving author")
 	assert.Equal(t, "John Doe", author.Name)
 	assert.Equal(t, "johndoe", *author.PicURL)
@@ -81,34 +92,36 @@ func TestGetAllAuthors(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author1 := Author{
 		ID:     nil,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	db.AddAuthor(author1)
-	authors := db.GetAuthors()
-	assert.Len(t, authors, 1, "Wrong number of authors, expected 1 got %d", len(authors))
+	db.AddAuthor(ctx, author1)
+	page, _ := db.ListAuthors(ctx, ListOptions{})
+	assert.Len(t, page.Authors, 1, "Wrong number of authors, expected 1 got %d", len(page.Authors))
 	author2 := Author{
 		ID:     nil,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	db.AddAuthor(author2)
-	authors = db.GetAuthors()
-	assert.Len(t, authors, 2, "Wrong number of authors, expected 1 got %d", len(authors))
+	db.AddAuthor(ctx, author2)
+	page, _ = db.ListAuthors(ctx, ListOptions{})
+	assert.Len(t, page.Authors, 2, "Wrong number of authors, expected 1 got %d", len(page.Authors))
 }
 
 func TestUpdateAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author1 := Author{
 		ID:     nil,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	authorId, err := db.AddAuthor(author1)
+	authorId, err := db.AddAuthor(ctx, author1)
 	assert.NoError(t, err, "Fail to add an author.")
 	newPicUrl := "newPicUrl"
 	newAuthor1 := Author{
@@ -116,9 +129,9 @@ func TestUpdateAuthor(t *testing.T) {
 		Name:   "Author1",
 		PicURL: &newPicUrl,
 	}
-	err = db.UpdateAuthor(newAuthor1)
+	err = db.UpdateAuthor(ctx, newAuthor1, UpdateOptions{})
 	assert.NoError(t, err, "Fail to update author data.")
-	var author, errGet = db.GetAuthor(authorId.String())
+	var author, errGet = db.GetAuthor(ctx, authorId.String())
 	assert.NoError(t, errGet, "Fail to get author")
 	assert.Equal(t, author.Name, "Author1")
 	assert.Equal(t, *author.PicURL, "newPicUrl")
@@ -128,12 +141,13 @@ func TestUpdateAuthorWithNilUUID(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author1 := Author{
 		ID:     nil,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	err := db.UpdateAuthor(author1)
+	err := db.UpdateAuthor(ctx, author1, UpdateOptions{})
 	assert.Error(t, err, "Able to update author.")
 }
 
@@ -141,13 +155,14 @@ func TestUpdateAuthorNonExistentAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	newUUID := uuid.New()
 	author1 := Author{
 		ID:     &newUUID,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	err := db.UpdateAuthor(author1)
+	err := db.UpdateAuthor(ctx, author1, UpdateOptions{})
 	assert.Error(t, err, "Able to update author.")
 }
 
@@ -155,16 +170,17 @@ func TestDeleteAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
+	ctx := context.Background()
 	author1 := Author{
 		ID:     nil,
 		Name:   "Author1",
 		PicURL: nil,
 	}
-	authorId, err := db.AddAuthor(author1)
+	authorId, err := db.AddAuthor(ctx, author1)
 	assert.NoError(t, err, "Fail to add an author.")
-	err = db.DeleteAuthor(authorId.String())
+	err = db.DeleteAuthor(ctx, authorId.String(), DeleteOptions{})
 	assert.NoError(t, err, "Fail to delete author data.")
-	var author, errGet = db.GetAuthor(authorId.String())
+	var author, errGet = db.GetAuthor(ctx, authorId.String())
 	assert.Error(t, errGet, "Not able to get author data because was deleted.")
 	assert.Nil(t, author, "Author was not deleted but retrieved.")
 }
@@ -173,17 +189,49 @@ func TestDeleteAuthorNonExistentAuthor(t *testing.T) {
 	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
 	db := NewConnection(sqliteDialector)
 	defer db.CloseDatabase()
-	err := db.DeleteAuthor("NonExistentUUID")
+	ctx := context.Background()
+	err := db.DeleteAuthor(ctx, "NonExistentUUID", DeleteOptions{})
 	assert.Error(t, err, "Able to delete entry.")
 }
 
 func TestUUIDCorrectingParsing(t *testing.T) {
 	newUuid := uuid.New()
-	parsed := uuidParseOrCreate(newUuid.String())
+	parsed, err := parseUUID(newUuid.String())
+	assert.NoError(t, err)
 	assert.Equal(t, newUuid.String(), parsed.String())
 }
 
-func TestUUIDCreatingNewUuidFromInvalidEntry(t *testing.T) {
-	parsed := uuidParseOrCreate("Invalid")
-	assert.NotEqual(t, parsed.String(), "Invalid")
+func TestUUIDParsingInvalidEntry(t *testing.T) {
+	_, err := parseUUID("Invalid")
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestDbConnectorConformance(t *testing.T) {
+	runAuthorStoreConformance(t, func(t *testing.T) AuthorStore {
+		sqliteDialector := sqlite.Open("file::memory:?cache=shared")
+		db := NewConnection(sqliteDialector)
+		t.Cleanup(func() { assert.NoError(t, db.CloseDatabase()) })
+		return db
+	})
+}
+
+func TestTranslateError(t *testing.T) {
+	assert.Nil(t, translateError(nil))
+	assert.ErrorIs(t, translateError(gorm.ErrRecordNotFound), ErrAuthorNotFound)
+	assert.Equal(t, apperror.AlreadyExists, apperror.CodeOf(translateError(&pgconn.PgError{Code: "23505"})))
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(translateError(&pgconn.PgError{Code: "22P02"})))
+	assert.Equal(t, apperror.Unavailable, apperror.CodeOf(translateError(&pgconn.PgError{Code: "08006"})))
+	assert.Equal(t, apperror.Unavailable, apperror.CodeOf(translateError(driver.ErrBadConn)))
+	assert.Equal(t, apperror.Unavailable, apperror.CodeOf(translateError(&net.OpError{Op: "dial", Err: errors.New("refused")})))
+	internal := translateError(errors.New("unexpected"))
+	assert.Equal(t, apperror.Internal, apperror.CodeOf(internal))
+	assert.Equal(t, "database error", apperror.MessageOf(internal))
+}
+
+func TestPing(t *testing.T) {
+	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
+	db := NewConnection(sqliteDialector)
+	assert.NoError(t, db.Ping(context.Background()))
+	assert.NoError(t, db.CloseDatabase())
+	assert.Error(t, db.Ping(context.Background()))
 }
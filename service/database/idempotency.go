@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"service/apperror"
+	"service/metadata"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrAlreadyProcessed Returned when changing an author on behalf of a message that already changed
+// it, identified by the idempotency key and fingerprint carried by the context. Nothing is changed.
+var ErrAlreadyProcessed = apperror.New(apperror.AlreadyExists, "message already processed")
+
+// ProcessedMessage Broker message that changed an author, recorded on the same transaction as the
+// change so redeliveries of the message are answered without applying it again. Messages sharing a
+// key, as the ones of a flow sharing a correlation id, are recorded apart by their fingerprint.
+type ProcessedMessage struct {
+	// Key Message id, or correlation id when not set, of the processed message.
+	Key string `gorm:"primaryKey"`
+	// Fingerprint Hash of the content of the processed message, its action and payload.
+	Fingerprint string `gorm:"primaryKey"`
+	// AuthorID Author changed by the message, sent back again to redeliveries of creations.
+	AuthorID  uuid.UUID
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// IdempotencyStore Store of the broker messages that changed authors. The stores record a message
+// when changing an author with a context carrying its idempotency key and fingerprint, failing with
+// ErrAlreadyProcessed when it was already recorded.
+type IdempotencyStore interface {
+	// GetProcessedMessage Queries the message processed with the key and fingerprint, returning
+	// nil when there is none.
+	GetProcessedMessage(ctx context.Context, key string, fingerprint string) (*ProcessedMessage, error)
+	// PurgeProcessedMessages Deletes the messages processed before the passed time, returning how
+	// many were deleted.
+	PurgeProcessedMessages(ctx context.Context, processedBefore time.Time) (int64, error)
+}
+
+var _ IdempotencyStore = (*DbConnector)(nil)
+var _ IdempotencyStore = (*MemoryStore)(nil)
+
+// GetProcessedMessage Queries the message processed with the key and fingerprint, returning nil
+// when there is none.
+func (database *DbConnector) GetProcessedMessage(ctx context.Context, key string, fingerprint string) (*ProcessedMessage, error) {
+	var message ProcessedMessage
+	err := database.Database.WithContext(ctx).Where("key = ? AND fingerprint = ?", key, fingerprint).
+		First(&message).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &message, nil
+}
+
+// recordProcessed Records the message whose idempotency key and fingerprint are carried by the
+// context of the transaction as processed, changing the author of entry. Fails with ErrAlreadyProcessed when it
+// was already recorded, rolling back the change.
+func recordProcessed(tx *gorm.DB, entry *AuditEntry) error {
+	key, fingerprint := metadata.IdempotencyKeyFrom(tx.Statement.Context)
+	if key == "" {
+		return nil
+	}
+	message := ProcessedMessage{Key: key, Fingerprint: fingerprint, AuthorID: entry.AuthorID, CreatedAt: now()}
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&message)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAlreadyProcessed
+	}
+	return nil
+}
+
+// PurgeProcessedMessages Deletes the messages processed before the passed time.
+func (database *DbConnector) PurgeProcessedMessages(ctx context.Context, processedBefore time.Time) (int64, error) {
+	result := database.Database.WithContext(ctx).Where("created_at < ?", processedBefore.UTC()).
+		Delete(&ProcessedMessage{})
+	if result.Error != nil {
+		return 0, translateError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetProcessedMessage Queries the message processed with the key and fingerprint, returning nil
+// when there is none.
+func (store *MemoryStore) GetProcessedMessage(_ context.Context, key string, fingerprint string) (*ProcessedMessage, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	message, ok := store.processed[processedKey{key: key, fingerprint: fingerprint}]
+	if !ok {
+		return nil, nil
+	}
+	return &message, nil
+}
+
+// processedKey Identifies a ProcessedMessage on the MemoryStore.
+type processedKey struct {
+	key         string
+	fingerprint string
+}
+
+// checkProcessed Fails with ErrAlreadyProcessed when the message whose idempotency key and
+// fingerprint are carried by ctx was already recorded. Must be called holding the mutex, before
+// changing anything.
+func (store *MemoryStore) checkProcessed(ctx context.Context) error {
+	key, fingerprint := metadata.IdempotencyKeyFrom(ctx)
+	if _, ok := store.processed[processedKey{key: key, fingerprint: fingerprint}]; ok {
+		return ErrAlreadyProcessed
+	}
+	return nil
+}
+
+// PurgeProcessedMessages Deletes the messages processed before the passed time.
+func (store *MemoryStore) PurgeProcessedMessages(_ context.Context, processedBefore time.Time) (int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var purged int64
+	for key, message := range store.processed {
+		if message.CreatedAt.Before(processedBefore) {
+			delete(store.processed, key)
+			purged++
+		}
+	}
+	return purged, nil
+}
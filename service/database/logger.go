@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// SlowQueryThreshold Duration after which a query is logged as slow.
+const SlowQueryThreshold = 200 * time.Millisecond
+
+// queryLogger gorm logger writing to a slog.Logger. Failed and slow queries are logged as
+// warnings, and every query on the debug level.
+type queryLogger struct {
+	logger *slog.Logger
+}
+
+var _ gormLogger.Interface = queryLogger{}
+
+// LogMode Returns the logger unchanged, the level being set on the slog.Logger.
+func (logger queryLogger) LogMode(gormLogger.LogLevel) gormLogger.Interface {
+	return logger
+}
+
+// Info Logs an informational message of gorm.
+func (logger queryLogger) Info(ctx context.Context, message string, data ...interface{}) {
+	logger.logger.InfoContext(ctx, fmt.Sprintf(message, data...))
+}
+
+// Warn Logs a warning of gorm.
+func (logger queryLogger) Warn(ctx context.Context, message string, data ...interface{}) {
+	logger.logger.WarnContext(ctx, fmt.Sprintf(message, data...))
+}
+
+// Error Logs an error of gorm.
+func (logger queryLogger) Error(ctx context.Context, message string, data ...interface{}) {
+	logger.logger.ErrorContext(ctx, fmt.Sprintf(message, data...))
+}
+
+// Trace Logs a query run by gorm, with its sql, the affected rows and its duration. Authors not
+// being found is expected, so isn't logged as a failure.
+func (logger queryLogger) Trace(ctx context.Context, begin time.Time, query func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	failed := err != nil && !errors.Is(err, gorm.ErrRecordNotFound)
+	level := slog.LevelDebug
+	message := "Database query"
+	switch {
+	case failed:
+		level = slog.LevelWarn
+		message = "Database query failed"
+	case elapsed > SlowQueryThreshold:
+		level = slog.LevelWarn
+		message = "Slow database query"
+	}
+	if !logger.logger.Enabled(ctx, level) {
+		return
+	}
+	sql, rows := query()
+	attrs := []slog.Attr{slog.String("sql", sql), slog.Int64("rows", rows), slog.Duration("duration", elapsed)}
+	if failed {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.logger.LogAttrs(ctx, level, message, attrs...)
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestQueryLogger_Trace(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := queryLogger{logger: slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: slog.LevelWarn}))}
+	query := func() (string, int64) { return "SELECT * FROM authors", 1 }
+	ctx := context.Background()
+
+	logger.Trace(ctx, time.Now(), query, nil)
+	logger.Trace(ctx, time.Now(), query, gorm.ErrRecordNotFound)
+	assert.Empty(t, output.String())
+
+	logger.Trace(ctx, time.Now(), query, errors.New("connection refused"))
+	assert.Contains(t, output.String(), `msg="Database query failed"`)
+	assert.Contains(t, output.String(), `error="connection refused"`)
+
+	output.Reset()
+	logger.Trace(ctx, time.Now().Add(-time.Second), query, nil)
+	assert.Contains(t, output.String(), `msg="Slow database query"`)
+	assert.Contains(t, output.String(), `sql="SELECT * FROM authors" rows=1`)
+}
+
+func TestNewConnection_WithLogger(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	db := NewConnection(sqlite.Open("file::memory:?cache=shared"), WithLogger(logger))
+	t.Cleanup(func() { assert.NoError(t, db.CloseDatabase()) })
+
+	_, err := db.GetAuthor(context.Background(), "a4ba2e3f-9b7e-4a4b-9b65-1e1b0d1f0c0a")
+	assert.ErrorIs(t, err, ErrAuthorNotFound)
+	assert.Contains(t, output.String(), `msg="Migrating the database"`)
+	assert.Contains(t, output.String(), `msg="Database query"`)
+}
@@ -0,0 +1,276 @@
+package database
+
+import (
+	"context"
+	"service/metadata"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MemoryStore AuthorStore that keeps all authors in memory. Useful for tests and local
+// development where a real database is not needed.
+type MemoryStore struct {
+	mutex       sync.RWMutex
+	authors     map[uuid.UUID]Author
+	history     map[uuid.UUID][]AuditEntry
+	lastAuditID uint64
+	outbox      []OutboxMessage
+	// lastOutboxID ID of the last message added to the outbox, as the sent ones are purged.
+	lastOutboxID uint64
+	// sending Held by the relay sending the outbox.
+	sending   sync.Mutex
+	processed map[processedKey]ProcessedMessage
+}
+
+var _ AuthorStore = (*MemoryStore)(nil)
+
+// NewMemoryStore Creates a new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		authors:   map[uuid.UUID]Author{},
+		history:   map[uuid.UUID][]AuditEntry{},
+		processed: map[processedKey]ProcessedMessage{},
+	}
+}
+
+// copyAuthor Returns a copy of the author that doesn't share pointers with the original one.
+func copyAuthor(author Author) Author {
+	copied := author
+	if author.ID != nil {
+		id := *author.ID
+		copied.ID = &id
+	}
+	if author.PicURL != nil {
+		picURL := *author.PicURL
+		copied.PicURL = &picURL
+	}
+	return copied
+}
+
+// active Returns the author registered with the id, unless it is missing or soft deleted. Must
+// be called holding the mutex.
+func (store *MemoryStore) active(id uuid.UUID) (Author, bool) {
+	author, ok := store.authors[id]
+	if !ok || author.DeletedAt.Valid {
+		return Author{}, false
+	}
+	return author, true
+}
+
+// record Appends the change of the author to its history, keeping copies of the snapshots, and
+// the message notifying it to the outbox. Records the message carried by ctx as processed, as on
+// recordChange. Must be called holding the mutex.
+func (store *MemoryStore) record(ctx context.Context, operation AuditOperation, id uuid.UUID, before *Author, after *Author) {
+	entry := newAuditEntry(ctx, operation, id, nil, nil)
+	if before != nil {
+		copied := copyAuthor(*before)
+		entry.Before = &copied
+	}
+	if after != nil {
+		copied := copyAuthor(*after)
+		entry.After = &copied
+	}
+	store.lastAuditID++
+	entry.ID = store.lastAuditID
+	store.history[id] = append(store.history[id], *entry)
+	if message := newOutboxMessage(*entry); message != nil {
+		store.lastOutboxID++
+		message.ID = store.lastOutboxID
+		store.outbox = append(store.outbox, *message)
+	}
+	if key, fingerprint := metadata.IdempotencyKeyFrom(ctx); key != "" {
+		store.processed[processedKey{key: key, fingerprint: fingerprint}] = ProcessedMessage{
+			Key:         key,
+			Fingerprint: fingerprint,
+			AuthorID:    id,
+			CreatedAt:   entry.CreatedAt,
+		}
+	}
+}
+
+// AddAuthor Adds an author to the store.
+func (store *MemoryStore) AddAuthor(ctx context.Context, author Author) (*uuid.UUID, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.checkProcessed(ctx); err != nil {
+		return nil, err
+	}
+	authorToAdd := copyAuthor(author)
+	if authorToAdd.ID == nil {
+		newUUID := uuid.New()
+		authorToAdd.ID = &newUUID
+	}
+	if _, ok := store.authors[*authorToAdd.ID]; ok {
+		return nil, ErrAuthorAlreadyExists
+	}
+	authorToAdd.Version = 1
+	authorToAdd.CreatedAt = now()
+	authorToAdd.UpdatedAt = authorToAdd.CreatedAt
+	store.authors[*authorToAdd.ID] = authorToAdd
+	store.record(ctx, AuditCreate, *authorToAdd.ID, nil, &authorToAdd)
+	id := *authorToAdd.ID
+	return &id, nil
+}
+
+// GetAuthor Queries an author on the store using the uuid.
+func (store *MemoryStore) GetAuthor(_ context.Context, id string) (*Author, error) {
+	parsed, err := parseUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	author, ok := store.active(parsed)
+	if !ok {
+		return nil, ErrAuthorNotFound
+	}
+	found := copyAuthor(author)
+	return &found, nil
+}
+
+// ListAuthors Gets one page of the authors on the store matching the options.
+func (store *MemoryStore) ListAuthors(_ context.Context, options ListOptions) (AuthorPage, error) {
+	cursor, err := options.cursor()
+	if err != nil {
+		return AuthorPage{}, err
+	}
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	var authors []Author
+	for _, author := range store.authors {
+		if !options.matches(author) {
+			continue
+		}
+		if cursor != nil && !cursor.after(author) {
+			continue
+		}
+		authors = append(authors, copyAuthor(author))
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		return options.OrderBy.less(authors[i], authors[j])
+	})
+	if len(authors) > options.limit()+1 {
+		authors = authors[:options.limit()+1]
+	}
+	return buildPage(authors, options), nil
+}
+
+// UpdateAuthor Updates the author entry with the new name and picUrl. As with the gorm
+// implementation, empty values are ignored unless the field is set on the options.
+func (store *MemoryStore) UpdateAuthor(ctx context.Context, author Author, options UpdateOptions) error {
+	if author.ID == nil {
+		return ErrMissingID
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.checkProcessed(ctx); err != nil {
+		return err
+	}
+	found, ok := store.active(*author.ID)
+	if !ok {
+		return ErrAuthorNotFound
+	}
+	if options.ExpectedVersion != 0 && options.ExpectedVersion != found.Version {
+		return ErrVersionConflict
+	}
+	before := found
+	updated := copyAuthor(author)
+	if options.Has(FieldName) || (len(options.Fields) == 0 && author.Name != "") {
+		found.Name = updated.Name
+	}
+	if options.Has(FieldPicURL) || (len(options.Fields) == 0 && author.PicURL != nil) {
+		found.PicURL = updated.PicURL
+	}
+	found.Version++
+	found.UpdatedAt = now()
+	found.UpdatedBy = author.UpdatedBy
+	store.authors[*author.ID] = found
+	store.record(ctx, AuditUpdate, *author.ID, &before, &found)
+	return nil
+}
+
+// DeleteAuthor Soft deletes an author from the store registered with the passed uuid.
+func (store *MemoryStore) DeleteAuthor(ctx context.Context, id string, options DeleteOptions) error {
+	parsed, err := parseUUID(id)
+	if err != nil {
+		return err
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.checkProcessed(ctx); err != nil {
+		return err
+	}
+	found, ok := store.active(parsed)
+	if !ok {
+		return ErrAuthorNotFound
+	}
+	if options.ExpectedVersion != 0 && options.ExpectedVersion != found.Version {
+		return ErrVersionConflict
+	}
+	before := found
+	found.DeletedAt = gorm.DeletedAt{Time: now(), Valid: true}
+	store.authors[parsed] = found
+	store.record(ctx, AuditDelete, parsed, &before, &found)
+	return nil
+}
+
+// RestoreAuthor Restores the soft deleted author registered with the passed uuid.
+func (store *MemoryStore) RestoreAuthor(ctx context.Context, id string) error {
+	parsed, err := parseUUID(id)
+	if err != nil {
+		return err
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err := store.checkProcessed(ctx); err != nil {
+		return err
+	}
+	found, ok := store.authors[parsed]
+	if !ok {
+		return ErrAuthorNotFound
+	}
+	if !found.DeletedAt.Valid {
+		return nil
+	}
+	before := found
+	found.DeletedAt = gorm.DeletedAt{}
+	store.authors[parsed] = found
+	store.record(ctx, AuditRestore, parsed, &before, &found)
+	return nil
+}
+
+// PurgeAuthors Permanently deletes the authors soft deleted before the passed time.
+func (store *MemoryStore) PurgeAuthors(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var purged int64
+	for id, author := range store.authors {
+		if author.DeletedAt.Valid && author.DeletedAt.Time.Before(deletedBefore) {
+			delete(store.authors, id)
+			store.record(ctx, AuditPurge, id, &author, nil)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// GetAuthorHistory Queries the changes made to the author registered with the passed uuid.
+func (store *MemoryStore) GetAuthorHistory(_ context.Context, id string) ([]AuditEntry, error) {
+	parsed, err := parseUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	history, ok := store.history[parsed]
+	if !ok {
+		return nil, ErrAuthorNotFound
+	}
+	entries := make([]AuditEntry, len(history))
+	copy(entries, history)
+	return entries, nil
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryStoreConformance(t *testing.T) {
+	runAuthorStoreConformance(t, func(t *testing.T) AuthorStore {
+		return NewMemoryStore()
+	})
+}
+
+func TestMemoryStoreReturnsCopies(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	picURL := "picUrl"
+	newUUID := uuid.New()
+	_, err := store.AddAuthor(ctx, Author{ID: &newUUID, Name: "John Doe", PicURL: &picURL})
+	assert.NoError(t, err)
+	picURL = "changed"
+	author, err := store.GetAuthor(ctx, newUUID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, "picUrl", *author.PicURL)
+	author.Name = "Changed"
+	author, err = store.GetAuthor(ctx, newUUID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", author.Name)
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxMessage Change notification written on the same transaction as the change, kept until it
+// is published so notifications are neither lost nor published for changes rolled back. Sent
+// messages are kept until purged.
+type OutboxMessage struct {
+	// ID Position of the message on the outbox, messages are published in its order.
+	ID           uint64 `gorm:"primaryKey;autoIncrement"`
+	AuditEntryID uint64
+	AuthorID     uuid.UUID
+	// Payload Binary encoding of the AuthorChanged notification.
+	Payload   []byte
+	CreatedAt time.Time
+	// SentAt Time the message was published, nil while it is pending.
+	SentAt *time.Time `gorm:"index"`
+	// Attempts Number of failed attempts to publish the message.
+	Attempts  int
+	LastError string
+}
+
+// Outbox Store of the change notifications pending to be published, written by the AuthorStore
+// along with every notified change.
+type Outbox interface {
+	// PendingOutboxMessages Queries up to limit messages not yet sent, oldest first.
+	PendingOutboxMessages(ctx context.Context, limit int) ([]OutboxMessage, error)
+	// ClaimOutboxMessages Locks up to limit messages not yet sent, oldest first, skipping the ones
+	// locked by other relays, and calls send with them and the Outbox to mark them through. The
+	// messages stay locked, and the marks are kept, until send returns without error, so relays
+	// sharing the store never publish the same message at once.
+	ClaimOutboxMessages(ctx context.Context, limit int, send func(outbox Outbox, messages []OutboxMessage) error) error
+	// MarkOutboxMessageSent Records that the message was published, so it isn't sent again.
+	MarkOutboxMessageSent(ctx context.Context, id uint64) error
+	// MarkOutboxMessageFailed Records a failed attempt to publish the message, which is kept
+	// pending.
+	MarkOutboxMessageFailed(ctx context.Context, id uint64, reason error) error
+	// PurgeOutboxMessages Deletes the messages sent before the passed time, returning how many were
+	// deleted. Pending messages are never deleted.
+	PurgeOutboxMessages(ctx context.Context, sentBefore time.Time) (int64, error)
+}
+
+var _ Outbox = (*DbConnector)(nil)
+var _ Outbox = (*MemoryStore)(nil)
+
+// newOutboxMessage Creates the OutboxMessage notifying the change recorded by the entry, or nil
+// when the change isn't notified.
+func newOutboxMessage(entry AuditEntry) *OutboxMessage {
+	change := AuthorChangedToGrpc(entry)
+	if change == nil {
+		return nil
+	}
+	payload, _ := proto.Marshal(change)
+	return &OutboxMessage{
+		AuditEntryID: entry.ID,
+		AuthorID:     entry.AuthorID,
+		Payload:      payload,
+		CreatedAt:    now(),
+	}
+}
+
+// recordChange Writes the audit entry of a change along with the outbox message notifying it and,
+// when the change was requested by a broker message, the message as processed.
+func recordChange(tx *gorm.DB, entry *AuditEntry) error {
+	if err := recordProcessed(tx, entry); err != nil {
+		return err
+	}
+	if err := tx.Create(entry).Error; err != nil {
+		return err
+	}
+	message := newOutboxMessage(*entry)
+	if message == nil {
+		return nil
+	}
+	return tx.Create(message).Error
+}
+
+// PendingOutboxMessages Queries up to limit messages not yet sent, oldest first.
+func (database *DbConnector) PendingOutboxMessages(ctx context.Context, limit int) ([]OutboxMessage, error) {
+	var messages []OutboxMessage
+	err := database.Database.WithContext(ctx).Where("sent_at IS NULL").Order("id").Limit(limit).
+		Find(&messages).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return messages, nil
+}
+
+// ClaimOutboxMessages Locks up to limit pending messages, oldest first, with FOR UPDATE SKIP LOCKED,
+// and calls send with them and a DbConnector running on the same transaction. The transaction is
+// rolled back when send fails. SQLite doesn't lock rows, serializing the transactions instead.
+func (database *DbConnector) ClaimOutboxMessages(ctx context.Context, limit int,
+	send func(outbox Outbox, messages []OutboxMessage) error) error {
+	err := database.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var messages []OutboxMessage
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where("sent_at IS NULL").
+			Order("id").Limit(limit).Find(&messages).Error
+		if err != nil {
+			return err
+		}
+		return send(&DbConnector{Database: tx, logger: database.logger}, messages)
+	})
+	return translateError(err)
+}
+
+// MarkOutboxMessageSent Records that the message was published.
+func (database *DbConnector) MarkOutboxMessageSent(ctx context.Context, id uint64) error {
+	err := database.Database.WithContext(ctx).Model(&OutboxMessage{}).Where("id = ?", id).
+		Update("sent_at", now()).Error
+	return translateError(err)
+}
+
+// MarkOutboxMessageFailed Records a failed attempt to publish the message.
+func (database *DbConnector) MarkOutboxMessageFailed(ctx context.Context, id uint64, reason error) error {
+	err := database.Database.WithContext(ctx).Model(&OutboxMessage{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason.Error(),
+		}).Error
+	return translateError(err)
+}
+
+// PurgeOutboxMessages Deletes the messages sent before the passed time.
+func (database *DbConnector) PurgeOutboxMessages(ctx context.Context, sentBefore time.Time) (int64, error) {
+	result := database.Database.WithContext(ctx).Where("sent_at IS NOT NULL AND sent_at < ?", sentBefore.UTC()).
+		Delete(&OutboxMessage{})
+	if result.Error != nil {
+		return 0, translateError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// PendingOutboxMessages Queries up to limit messages not yet sent, oldest first.
+func (store *MemoryStore) PendingOutboxMessages(_ context.Context, limit int) ([]OutboxMessage, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	var messages []OutboxMessage
+	for _, message := range store.outbox {
+		if len(messages) == limit {
+			break
+		}
+		if message.SentAt == nil {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// MarkOutboxMessageSent Records that the message was published.
+func (store *MemoryStore) MarkOutboxMessageSent(_ context.Context, id uint64) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for i := range store.outbox {
+		if store.outbox[i].ID == id {
+			sentAt := now()
+			store.outbox[i].SentAt = &sentAt
+		}
+	}
+	return nil
+}
+
+// MarkOutboxMessageFailed Records a failed attempt to publish the message.
+func (store *MemoryStore) MarkOutboxMessageFailed(_ context.Context, id uint64, reason error) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for i := range store.outbox {
+		if store.outbox[i].ID == id {
+			store.outbox[i].Attempts++
+			store.outbox[i].LastError = reason.Error()
+		}
+	}
+	return nil
+}
+
+// ClaimOutboxMessages Calls send with up to limit pending messages, oldest first, and the store
+// itself. A single relay sends at a time, the others are called with no messages. Marks are kept
+// even when send fails.
+func (store *MemoryStore) ClaimOutboxMessages(ctx context.Context, limit int,
+	send func(outbox Outbox, messages []OutboxMessage) error) error {
+	if !store.sending.TryLock() {
+		return send(store, nil)
+	}
+	defer store.sending.Unlock()
+	messages, err := store.PendingOutboxMessages(ctx, limit)
+	if err != nil {
+		return err
+	}
+	return send(store, messages)
+}
+
+// PurgeOutboxMessages Deletes the messages sent before the passed time.
+func (store *MemoryStore) PurgeOutboxMessages(_ context.Context, sentBefore time.Time) (int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	kept := store.outbox[:0]
+	for _, message := range store.outbox {
+		if message.SentAt == nil || !message.SentAt.Before(sentBefore) {
+			kept = append(kept, message)
+		}
+	}
+	purged := int64(len(store.outbox) - len(kept))
+	store.outbox = kept
+	return purged, nil
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPageSize Number of authors returned per page when the caller doesn't set one.
+	DefaultPageSize = 50
+	// MaxPageSize Maximum number of authors returned on a single page.
+	MaxPageSize = 500
+)
+
+// Order Field used to sort author listings.
+type Order int
+
+const (
+	// OrderByID Sorts authors by uuid.
+	OrderByID Order = iota
+	// OrderByName Sorts authors by name, using the uuid to break ties.
+	OrderByName
+	// OrderByCreatedAt Sorts authors by the time they were added, using the uuid to break ties.
+	OrderByCreatedAt
+	// OrderByUpdatedAt Sorts authors by the time they were last changed, using the uuid to break
+	// ties.
+	OrderByUpdatedAt
+)
+
+// sortsByTime Checks if the order sorts authors by one of their timestamps.
+func (order Order) sortsByTime() bool {
+	return order == OrderByCreatedAt || order == OrderByUpdatedAt
+}
+
+// timeOf Returns the timestamp of the author used by the order.
+func (order Order) timeOf(author Author) time.Time {
+	if order == OrderByUpdatedAt {
+		return author.UpdatedAt
+	}
+	return author.CreatedAt
+}
+
+// ListOptions Pagination and filtering options used when listing authors.
+type ListOptions struct {
+	// PageSize Maximum number of authors on the page. Values out of range are clamped.
+	PageSize int
+	// PageToken Token returned on the previous AuthorPage, empty for the first page.
+	PageToken string
+	// OrderBy Field used to sort the authors. Must be the same for every page.
+	OrderBy Order
+	// NamePrefix Only list authors whose name starts with this value, ignoring case.
+	NamePrefix string
+	// NameContains Only list authors whose name contains this value, ignoring case.
+	NameContains string
+	// IncludeDeleted Also list the soft deleted authors.
+	IncludeDeleted bool
+}
+
+// AuthorPage Page of authors returned by a listing.
+type AuthorPage struct {
+	Authors []Author
+	// NextPageToken Token to fetch the following page, empty when there are no more authors.
+	NextPageToken string
+}
+
+// pageCursor Position of the last author returned on a page. Serialized as the page token.
+type pageCursor struct {
+	OrderBy Order      `json:"o"`
+	ID      string     `json:"i"`
+	Name    string     `json:"n,omitempty"`
+	Time    *time.Time `json:"t,omitempty"`
+}
+
+// limit Returns the page size to use, applying the default and maximum values.
+func (options ListOptions) limit() int {
+	if options.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	if options.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return options.PageSize
+}
+
+// cursor Decodes the page token of the options. Returns nil for the first page.
+func (options ListOptions) cursor() (*pageCursor, error) {
+	if options.PageToken == "" {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(options.PageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	if cursor.OrderBy != options.OrderBy || (cursor.OrderBy.sortsByTime() && cursor.Time == nil) {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
+
+// matches Checks if the author passes the filters of the options.
+func (options ListOptions) matches(author Author) bool {
+	if author.DeletedAt.Valid && !options.IncludeDeleted {
+		return false
+	}
+	name := strings.ToLower(author.Name)
+	if !strings.HasPrefix(name, strings.ToLower(options.NamePrefix)) {
+		return false
+	}
+	return strings.Contains(name, strings.ToLower(options.NameContains))
+}
+
+// after Checks if the author comes after the cursor on the listing order.
+func (cursor *pageCursor) after(author Author) bool {
+	id := author.ID.String()
+	if cursor.OrderBy == OrderByName && author.Name != cursor.Name {
+		return author.Name > cursor.Name
+	}
+	if cursor.OrderBy.sortsByTime() {
+		value := cursor.OrderBy.timeOf(author)
+		if !value.Equal(*cursor.Time) {
+			return value.After(*cursor.Time)
+		}
+	}
+	return id > cursor.ID
+}
+
+// less Compares two authors using the listing order.
+func (order Order) less(first Author, second Author) bool {
+	if order == OrderByName && first.Name != second.Name {
+		return first.Name < second.Name
+	}
+	if order.sortsByTime() && !order.timeOf(first).Equal(order.timeOf(second)) {
+		return order.timeOf(first).Before(order.timeOf(second))
+	}
+	return first.ID.String() < second.ID.String()
+}
+
+// buildPage Creates the AuthorPage from the authors found. The authors slice can hold one
+// more author than the page size, signaling that there is a next page.
+func buildPage(authors []Author, options ListOptions) AuthorPage {
+	limit := options.limit()
+	if len(authors) <= limit {
+		return AuthorPage{Authors: authors}
+	}
+	authors = authors[:limit]
+	last := authors[limit-1]
+	cursor := pageCursor{
+		OrderBy: options.OrderBy,
+		ID:      last.ID.String(),
+	}
+	if options.OrderBy == OrderByName {
+		cursor.Name = last.Name
+	}
+	if options.OrderBy.sortsByTime() {
+		value := options.OrderBy.timeOf(last)
+		cursor.Time = &value
+	}
+	encoded, _ := json.Marshal(cursor)
+	return AuthorPage{
+		Authors:       authors,
+		NextPageToken: base64.RawURLEncoding.EncodeToString(encoded),
+	}
+}
+
+// escapeLike Escapes the wildcards of a LIKE pattern so the value is matched literally.
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(strings.ToLower(value))
+}
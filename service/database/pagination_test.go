@@ -0,0 +1,63 @@
+package database
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestListOptionsLimit(t *testing.T) {
+	assert.Equal(t, DefaultPageSize, ListOptions{}.limit())
+	assert.Equal(t, 10, ListOptions{PageSize: 10}.limit())
+	assert.Equal(t, MaxPageSize, ListOptions{PageSize: MaxPageSize + 1}.limit())
+}
+
+func TestBuildPageWithoutNextPage(t *testing.T) {
+	newUUID := uuid.New()
+	page := buildPage([]Author{{ID: &newUUID, Name: "John Doe"}}, ListOptions{PageSize: 1})
+	assert.Len(t, page.Authors, 1)
+	assert.Empty(t, page.NextPageToken)
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	options := ListOptions{PageSize: 1, OrderBy: OrderByName}
+	page := buildPage([]Author{{ID: &first, Name: "Anna"}, {ID: &second, Name: "Bob"}}, options)
+	assert.Len(t, page.Authors, 1)
+	assert.NotEmpty(t, page.NextPageToken)
+	options.PageToken = page.NextPageToken
+	cursor, err := options.cursor()
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), cursor.ID)
+	assert.Equal(t, "Anna", cursor.Name)
+	assert.True(t, cursor.after(Author{ID: &second, Name: "Bob"}))
+	assert.False(t, cursor.after(Author{ID: &first, Name: "Anna"}))
+}
+
+func TestPageTokenByTime(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	createdAt := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	options := ListOptions{PageSize: 1, OrderBy: OrderByCreatedAt}
+	page := buildPage([]Author{
+		{ID: &first, CreatedAt: createdAt},
+		{ID: &second, CreatedAt: createdAt.Add(time.Second)},
+	}, options)
+	options.PageToken = page.NextPageToken
+	cursor, err := options.cursor()
+	assert.NoError(t, err)
+	assert.True(t, createdAt.Equal(*cursor.Time))
+	assert.True(t, cursor.after(Author{ID: &second, CreatedAt: createdAt.Add(time.Second)}))
+	assert.False(t, cursor.after(Author{ID: &second, CreatedAt: createdAt.Add(-time.Second)}))
+}
+
+func TestPageTokenWithDifferentOrder(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	page := buildPage([]Author{{ID: &first}, {ID: &second}}, ListOptions{PageSize: 1})
+	_, err := ListOptions{PageToken: page.NextPageToken, OrderBy: OrderByName}.cursor()
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `100\% \_a\\b`, escapeLike(`100% _A\b`))
+}
@@ -1,25 +1,58 @@
 package database
 
-import authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+import (
+	"fmt"
+	"service/apperror"
+	"time"
 
-// AuthorFromGrpc Transforms an Author proto into an Author object.
-func AuthorFromGrpc(author *authorManagementProto.Author) Author {
-	parsedUUID := uuidParseOrCreate(*author.Uuid)
-	return Author{
-		ID:     &parsedUUID,
-		Name:   author.Name,
-		PicURL: author.PicUrl,
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuthorFromGrpc Transforms an Author proto into an Author object. An absent or empty uuid
+// leaves the ID unset, so a new one is generated when the author is added, while a malformed
+// one is rejected with ErrInvalidUUID.
+func AuthorFromGrpc(author *authorManagementProto.Author) (Author, error) {
+	parsedAuthor := Author{
+		Name:    author.Name,
+		PicURL:  author.PicUrl,
+		Version: author.Version,
+	}
+	if author.GetUuid() == "" {
+		return parsedAuthor, nil
+	}
+	parsedUUID, err := parseUUID(author.GetUuid())
+	if err != nil {
+		return Author{}, err
 	}
+	parsedAuthor.ID = &parsedUUID
+	return parsedAuthor, nil
 }
 
 // AuthorToGrpc Transforms an Author object into a proto Author.
 func AuthorToGrpc(author Author) *authorManagementProto.Author {
 	uuidString := author.ID.String()
 	return &authorManagementProto.Author{
-		Uuid:   &uuidString,
-		Name:   author.Name,
-		PicUrl: author.PicURL,
+		Uuid:      &uuidString,
+		Name:      author.Name,
+		PicUrl:    author.PicURL,
+		Version:   author.Version,
+		CreatedAt: timestampToGrpc(author.CreatedAt),
+		UpdatedAt: timestampToGrpc(author.UpdatedAt),
+		CreatedBy: author.CreatedBy,
+		UpdatedBy: author.UpdatedBy,
+		DeletedAt: timestampToGrpc(author.DeletedAt.Time),
+	}
+}
+
+// timestampToGrpc Transforms a time into a proto Timestamp, leaving it unset when the time is
+// zero.
+func timestampToGrpc(value time.Time) *timestamppb.Timestamp {
+	if value.IsZero() {
+		return nil
 	}
+	return timestamppb.New(value)
 }
 
 // AuthorListToGrpcList Transforms a list of Author into a AuthorList.
@@ -32,3 +65,127 @@ func AuthorListToGrpcList(author []Author) authorManagementProto.AuthorList {
 		Authors: parsedAuthors,
 	}
 }
+
+// AuthorPageToGrpcList Transforms a page of authors into an AuthorList carrying the token of
+// the next page.
+func AuthorPageToGrpcList(page AuthorPage) authorManagementProto.AuthorList {
+	var parsedAuthors []*authorManagementProto.Author
+	for _, author := range page.Authors {
+		parsedAuthors = append(parsedAuthors, AuthorToGrpc(author))
+	}
+	return authorManagementProto.AuthorList{
+		Authors:       parsedAuthors,
+		NextPageToken: page.NextPageToken,
+	}
+}
+
+// ListOptionsFromGrpc Transforms an AuthorQuery into the ListOptions used to list authors.
+func ListOptionsFromGrpc(query *authorManagementProto.AuthorQuery) ListOptions {
+	order := OrderByID
+	switch query.OrderBy {
+	case authorManagementProto.AuthorQuery_ORDER_BY_NAME:
+		order = OrderByName
+	case authorManagementProto.AuthorQuery_ORDER_BY_CREATED_AT:
+		order = OrderByCreatedAt
+	case authorManagementProto.AuthorQuery_ORDER_BY_UPDATED_AT:
+		order = OrderByUpdatedAt
+	}
+	return ListOptions{
+		PageSize:       int(query.PageSize),
+		PageToken:      query.PageToken,
+		OrderBy:        order,
+		NamePrefix:     query.NamePrefix,
+		NameContains:   query.NameContains,
+		IncludeDeleted: query.IncludeDeleted,
+	}
+}
+
+// UpdateOptionsFromGrpc Transforms the field mask of an update into the UpdateOptions used to
+// update authors. A nil or empty mask overwrites only the non empty fields, while unknown paths
+// are rejected with an InvalidArgument error.
+func UpdateOptionsFromGrpc(updateMask *fieldmaskpb.FieldMask) (UpdateOptions, error) {
+	var options UpdateOptions
+	var violations []apperror.FieldViolation
+	for _, path := range updateMask.GetPaths() {
+		field := Field(path)
+		switch field {
+		case FieldName, FieldPicURL:
+			if !options.Has(field) {
+				options.Fields = append(options.Fields, field)
+			}
+		default:
+			violations = append(violations, apperror.FieldViolation{
+				Field:       "updateMask",
+				Description: fmt.Sprintf("field %q can't be updated", path),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return UpdateOptions{}, apperror.Invalid("invalid update mask", violations)
+	}
+	return options, nil
+}
+
+// auditOperations Maps the audit operations into the ones sent on the AuditEntry.
+var auditOperations = map[AuditOperation]authorManagementProto.AuditEntry_Operation{
+	AuditCreate:  authorManagementProto.AuditEntry_CREATE,
+	AuditUpdate:  authorManagementProto.AuditEntry_UPDATE,
+	AuditDelete:  authorManagementProto.AuditEntry_DELETE,
+	AuditRestore: authorManagementProto.AuditEntry_RESTORE,
+	AuditPurge:   authorManagementProto.AuditEntry_PURGE,
+}
+
+// AuditEntryToGrpc Transforms an AuditEntry object into a proto AuditEntry.
+func AuditEntryToGrpc(entry AuditEntry) *authorManagementProto.AuditEntry {
+	parsedEntry := &authorManagementProto.AuditEntry{
+		Id:            entry.ID,
+		Uuid:          entry.AuthorID.String(),
+		Operation:     auditOperations[entry.Operation],
+		CorrelationId: entry.CorrelationID,
+		Actor:         entry.Actor,
+		CreatedAt:     timestampToGrpc(entry.CreatedAt),
+	}
+	if entry.Before != nil {
+		parsedEntry.Before = AuthorToGrpc(*entry.Before)
+	}
+	if entry.After != nil {
+		parsedEntry.After = AuthorToGrpc(*entry.After)
+	}
+	return parsedEntry
+}
+
+// AuthorHistoryToGrpc Transforms the audit entries of an author into an AuthorHistory.
+func AuthorHistoryToGrpc(entries []AuditEntry) *authorManagementProto.AuthorHistory {
+	history := &authorManagementProto.AuthorHistory{}
+	for _, entry := range entries {
+		history.Entries = append(history.Entries, AuditEntryToGrpc(entry))
+	}
+	return history
+}
+
+// authorChangeTypes Maps the audit operations into the types of change sent on AuthorChanged.
+// Purges aren't notified, as the author was already notified as deleted.
+var authorChangeTypes = map[AuditOperation]authorManagementProto.AuthorChanged_Type{
+	AuditCreate:  authorManagementProto.AuthorChanged_CREATED,
+	AuditUpdate:  authorManagementProto.AuthorChanged_UPDATED,
+	AuditDelete:  authorManagementProto.AuthorChanged_DELETED,
+	AuditRestore: authorManagementProto.AuthorChanged_RESTORED,
+}
+
+// AuthorChangedToGrpc Transforms an AuditEntry into the proto AuthorChanged notifying it, using the
+// entry id as the sequence. Returns nil for the operations that aren't notified.
+func AuthorChangedToGrpc(entry AuditEntry) *authorManagementProto.AuthorChanged {
+	changeType, ok := authorChangeTypes[entry.Operation]
+	if !ok || entry.After == nil {
+		return nil
+	}
+	return &authorManagementProto.AuthorChanged{
+		Type:          changeType,
+		Uuid:          entry.AuthorID.String(),
+		Author:        AuthorToGrpc(*entry.After),
+		Sequence:      entry.ID,
+		OccurredAt:    timestampToGrpc(entry.CreatedAt),
+		CorrelationId: entry.CorrelationID,
+		Actor:         entry.Actor,
+	}
+}
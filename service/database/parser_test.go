@@ -4,7 +4,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"service/apperror"
 	"testing"
+	"time"
 )
 
 func TestAuthorFromGrpc(t *testing.T) {
@@ -16,13 +19,30 @@ func TestAuthorFromGrpc(t *testing.T) {
 		Name:   expectedName,
 		PicUrl: &expectedPic,
 	}
-	parsedAuthor := AuthorFromGrpc(authorGrpc)
+	parsedAuthor, err := AuthorFromGrpc(authorGrpc)
+	assert.NoError(t, err)
 	picURL := *parsedAuthor.PicURL
 	assert.Equal(t, expectedUUID, parsedAuthor.ID.String())
 	assert.Equal(t, expectedName, parsedAuthor.Name)
 	assert.Equal(t, expectedPic, picURL)
 }
 
+func TestAuthorFromGrpcWithoutUUID(t *testing.T) {
+	emptyUUID := ""
+	for _, id := range []*string{nil, &emptyUUID} {
+		parsedAuthor, err := AuthorFromGrpc(&authorManagementProto.Author{Uuid: id, Name: "Test"})
+		assert.NoError(t, err)
+		assert.Nil(t, parsedAuthor.ID)
+		assert.Equal(t, "Test", parsedAuthor.Name)
+	}
+}
+
+func TestAuthorFromGrpcWithInvalidUUID(t *testing.T) {
+	invalidUUID := "Invalid"
+	_, err := AuthorFromGrpc(&authorManagementProto.Author{Uuid: &invalidUUID, Name: "Test"})
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
 func TestAuthorListToGrpcList(t *testing.T) {
 	var authorList []Author
 	expectedLen := 3
@@ -51,3 +71,82 @@ func TestAuthorToGrpc(t *testing.T) {
 	assert.Equal(t, &authorIDString, grpcAuthor.Uuid)
 	assert.Equal(t, author.PicURL, grpcAuthor.PicUrl)
 }
+
+func TestAuthorPageToGrpcList(t *testing.T) {
+	newUUID := uuid.New()
+	page := AuthorPage{
+		Authors:       []Author{{ID: &newUUID, Name: "John Doe"}},
+		NextPageToken: "token",
+	}
+	list := AuthorPageToGrpcList(page)
+	assert.Len(t, list.Authors, 1)
+	assert.Equal(t, "token", list.NextPageToken)
+}
+
+func TestListOptionsFromGrpc(t *testing.T) {
+	query := &authorManagementProto.AuthorQuery{
+		AllEntries:   true,
+		PageSize:     10,
+		PageToken:    "token",
+		OrderBy:      authorManagementProto.AuthorQuery_ORDER_BY_NAME,
+		NamePrefix:   "Jo",
+		NameContains: "Doe",
+	}
+	options := ListOptionsFromGrpc(query)
+	assert.Equal(t, 10, options.PageSize)
+	assert.Equal(t, "token", options.PageToken)
+	assert.Equal(t, OrderByName, options.OrderBy)
+	assert.Equal(t, "Jo", options.NamePrefix)
+	assert.Equal(t, "Doe", options.NameContains)
+}
+
+func TestUpdateOptionsFromGrpc(t *testing.T) {
+	options, err := UpdateOptionsFromGrpc(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, options.Fields)
+
+	options, err = UpdateOptionsFromGrpc(&fieldmaskpb.FieldMask{Paths: []string{"picUrl", "name", "picUrl"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Field{FieldPicURL, FieldName}, options.Fields)
+
+	_, err = UpdateOptionsFromGrpc(&fieldmaskpb.FieldMask{Paths: []string{"name", "uuid"}})
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	assert.Equal(t, []apperror.FieldViolation{{Field: "updateMask", Description: `field "uuid" can't be updated`}},
+		apperror.ViolationsOf(err))
+}
+
+func TestAuthorHistoryToGrpc(t *testing.T) {
+	id := uuid.New()
+	after := Author{ID: &id, Name: "John Doe", Version: 1}
+	history := AuthorHistoryToGrpc([]AuditEntry{
+		{ID: 1, AuthorID: id, Operation: AuditCreate, After: &after, CorrelationID: "42", Actor: "editor"},
+		{ID: 2, AuthorID: id, Operation: AuditPurge, Before: &after},
+	})
+	assert.Len(t, history.Entries, 2)
+	assert.Equal(t, authorManagementProto.AuditEntry_CREATE, history.Entries[0].Operation)
+	assert.Equal(t, id.String(), history.Entries[0].Uuid)
+	assert.Nil(t, history.Entries[0].Before)
+	assert.Equal(t, "John Doe", history.Entries[0].After.Name)
+	assert.Equal(t, "42", history.Entries[0].CorrelationId)
+	assert.Equal(t, "editor", history.Entries[0].Actor)
+	assert.Equal(t, authorManagementProto.AuditEntry_PURGE, history.Entries[1].Operation)
+	assert.Nil(t, history.Entries[1].After)
+}
+
+func TestAuthorChangedToGrpc(t *testing.T) {
+	id := uuid.New()
+	after := Author{ID: &id, Name: "Jane Doe", Version: 2}
+	change := AuthorChangedToGrpc(AuditEntry{
+		ID: 7, AuthorID: id, Operation: AuditUpdate, After: &after, CorrelationID: "42", Actor: "editor",
+		CreatedAt: time.Now(),
+	})
+	assert.Equal(t, authorManagementProto.AuthorChanged_UPDATED, change.Type)
+	assert.Equal(t, id.String(), change.Uuid)
+	assert.Equal(t, "Jane Doe", change.Author.Name)
+	assert.Equal(t, uint64(7), change.Sequence)
+	assert.NotNil(t, change.OccurredAt)
+	assert.Equal(t, "42", change.CorrelationId)
+	assert.Equal(t, "editor", change.Actor)
+
+	assert.Nil(t, AuthorChangedToGrpc(AuditEntry{ID: 8, AuthorID: id, Operation: AuditPurge, Before: &after}))
+}
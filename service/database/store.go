@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"service/apperror"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrAuthorNotFound Returned when there is no author registered with the requested uuid.
+	ErrAuthorNotFound = apperror.New(apperror.NotFound, "author not found")
+	// ErrAuthorAlreadyExists Returned when adding an author with an uuid that is already in use.
+	ErrAuthorAlreadyExists = apperror.New(apperror.AlreadyExists, "author already exists")
+	// ErrMissingID Returned when an operation that needs the author uuid receives none.
+	ErrMissingID = apperror.New(apperror.InvalidArgument, "can´t update author without proper id")
+	// ErrInvalidUUID Returned when the uuid passed is not a valid one.
+	ErrInvalidUUID = apperror.New(apperror.InvalidArgument, "invalid uuid")
+	// ErrInvalidPageToken Returned when listing authors with a page token that can't be used.
+	ErrInvalidPageToken = apperror.New(apperror.InvalidArgument, "invalid page token")
+	// ErrVersionConflict Returned when the author doesn't have the version expected by an update
+	// or delete.
+	ErrVersionConflict = apperror.New(apperror.Conflict, "author version doesn't match the expected one")
+)
+
+// Field Author field that can be overwritten by an update, named as on the proto Author.
+type Field string
+
+const (
+	// FieldName The name of the author.
+	FieldName Field = "name"
+	// FieldPicURL The picture URL of the author.
+	FieldPicURL Field = "picUrl"
+)
+
+// UpdateOptions Options used when updating an author.
+type UpdateOptions struct {
+	// Fields Fields overwritten by the update, even when the new value is empty, so they can be
+	// cleared. When empty, only the non empty fields of the author are overwritten.
+	Fields []Field
+	// ExpectedVersion Version the stored author must have for the update to be applied. Zero
+	// skips the check.
+	ExpectedVersion uint64
+}
+
+// DeleteOptions Options used when deleting an author.
+type DeleteOptions struct {
+	// ExpectedVersion Version the stored author must have for it to be deleted. Zero skips the
+	// check.
+	ExpectedVersion uint64
+}
+
+// Has Checks if the field is overwritten by the update.
+func (options UpdateOptions) Has(field Field) bool {
+	for _, value := range options.Fields {
+		if value == field {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorStore Storage used by the service to persist authors. Implementations must return the
+// typed errors declared on this package so callers can react to them independently of the
+// backend being used.
+type AuthorStore interface {
+	// AddAuthor Adds an author to the store, generating a new uuid when none is set. New authors
+	// start at version 1.
+	AddAuthor(ctx context.Context, author Author) (*uuid.UUID, error)
+	// GetAuthor Queries an author using the uuid.
+	GetAuthor(ctx context.Context, uuid string) (*Author, error)
+	// ListAuthors Gets one page of the authors matching the options.
+	ListAuthors(ctx context.Context, options ListOptions) (AuthorPage, error)
+	// UpdateAuthor Updates the author entry with the new name and picUrl, overwriting the fields
+	// set on the options, and increments its version. Returns ErrVersionConflict when the
+	// expected version doesn't match.
+	UpdateAuthor(ctx context.Context, author Author, options UpdateOptions) error
+	// DeleteAuthor Soft deletes the author registered with the passed uuid, hiding it until it is
+	// restored or purged. Returns ErrVersionConflict when the expected version doesn't match.
+	DeleteAuthor(ctx context.Context, uuid string, options DeleteOptions) error
+	// RestoreAuthor Restores the soft deleted author registered with the passed uuid. Restoring
+	// an author that is not deleted does nothing.
+	RestoreAuthor(ctx context.Context, uuid string) error
+	// PurgeAuthors Permanently deletes the authors soft deleted before the passed time, returning
+	// how many were purged.
+	PurgeAuthors(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// GetAuthorHistory Queries the audit log of the author registered with the passed uuid,
+	// oldest change first. Every change made by the other methods is recorded on it, along with
+	// the correlation id and actor carried by their context. The history is kept after the
+	// author is purged.
+	GetAuthorHistory(ctx context.Context, uuid string) ([]AuditEntry, error)
+}
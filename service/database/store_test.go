@@ -0,0 +1,528 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"service/metadata"
+	"testing"
+	"time"
+)
+
+// runAuthorStoreConformance Runs the behaviour every AuthorStore implementation must respect.
+// newStore is called once per sub test and must return an empty store.
+func runAuthorStoreConformance(t *testing.T, newStore func(t *testing.T) AuthorStore) {
+	t.Run("AddGeneratesUUID", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		assert.NotNil(t, id)
+		author, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", author.Name)
+	})
+
+	t.Run("AddKeepsPassedUUID", func(t *testing.T) {
+		store := newStore(t)
+		newUUID := uuid.New()
+		id, err := store.AddAuthor(context.Background(), Author{ID: &newUUID, Name: "John Doe"})
+		assert.NoError(t, err)
+		assert.Equal(t, newUUID.String(), id.String())
+	})
+
+	t.Run("AddDuplicatedUUID", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		_, err = store.AddAuthor(ctx, Author{ID: id, Name: "John Doe 2"})
+		assert.ErrorIs(t, err, ErrAuthorAlreadyExists)
+	})
+
+	t.Run("GetNonExistentAuthor", func(t *testing.T) {
+		store := newStore(t)
+		author, err := store.GetAuthor(context.Background(), uuid.NewString())
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+		assert.Nil(t, author)
+	})
+
+	t.Run("GetInvalidUUID", func(t *testing.T) {
+		store := newStore(t)
+		_, err := store.GetAuthor(context.Background(), "Invalid")
+		assert.ErrorIs(t, err, ErrInvalidUUID)
+	})
+
+	t.Run("ListAuthors", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		page, err := store.ListAuthors(ctx, ListOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 0)
+		for i := 0; i < 3; i++ {
+			_, err = store.AddAuthor(ctx, Author{Name: "Author"})
+			assert.NoError(t, err)
+		}
+		page, err = store.ListAuthors(ctx, ListOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 3)
+		assert.Empty(t, page.NextPageToken)
+	})
+
+	t.Run("ListAuthorsPaginated", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		for _, name := range []string{"Carl", "Anna", "Bob", "Anna", "Dave"} {
+			_, err := store.AddAuthor(ctx, Author{Name: name})
+			assert.NoError(t, err)
+		}
+		for _, order := range []Order{OrderByID, OrderByName} {
+			var listed []Author
+			options := ListOptions{PageSize: 2, OrderBy: order}
+			for pages := 1; ; pages++ {
+				page, err := store.ListAuthors(ctx, options)
+				assert.NoError(t, err)
+				assert.LessOrEqual(t, len(page.Authors), 2)
+				listed = append(listed, page.Authors...)
+				if page.NextPageToken == "" {
+					assert.Equal(t, 3, pages)
+					break
+				}
+				options.PageToken = page.NextPageToken
+			}
+			assert.Len(t, listed, 5)
+			for i := 1; i < len(listed); i++ {
+				assert.True(t, order.less(listed[i-1], listed[i]), "authors out of order")
+			}
+		}
+	})
+
+	t.Run("ListAuthorsFiltered", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		for _, name := range []string{"John Doe", "Jane Doe", "Doe John", "100% Author"} {
+			_, err := store.AddAuthor(ctx, Author{Name: name})
+			assert.NoError(t, err)
+		}
+		page, err := store.ListAuthors(ctx, ListOptions{NamePrefix: "jo"})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 1)
+		assert.Equal(t, "John Doe", page.Authors[0].Name)
+		page, err = store.ListAuthors(ctx, ListOptions{NameContains: "DOE", OrderBy: OrderByName})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 3)
+		assert.Equal(t, "Doe John", page.Authors[0].Name)
+		page, err = store.ListAuthors(ctx, ListOptions{NameContains: "0%"})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 1)
+		page, err = store.ListAuthors(ctx, ListOptions{NamePrefix: "j", NameContains: "john"})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 1)
+	})
+
+	t.Run("AuthorTimestamps", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		before := time.Now().Add(-time.Second)
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe", CreatedBy: "creator", UpdatedBy: "creator"})
+		assert.NoError(t, err)
+		created, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.True(t, created.CreatedAt.After(before))
+		assert.True(t, created.CreatedAt.Equal(created.UpdatedAt))
+		assert.Equal(t, "creator", created.CreatedBy)
+
+		time.Sleep(time.Millisecond)
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe", UpdatedBy: "editor"}, UpdateOptions{})
+		assert.NoError(t, err)
+		updated, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.True(t, updated.CreatedAt.Equal(created.CreatedAt))
+		assert.True(t, updated.UpdatedAt.After(created.UpdatedAt))
+		assert.Equal(t, "creator", updated.CreatedBy)
+		assert.Equal(t, "editor", updated.UpdatedBy)
+	})
+
+	t.Run("ListAuthorsByTimestamps", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		var ids []*uuid.UUID
+		for _, name := range []string{"John Doe", "Jane Doe", "Mary Major"} {
+			id, err := store.AddAuthor(ctx, Author{Name: name})
+			assert.NoError(t, err)
+			ids = append(ids, id)
+			time.Sleep(time.Millisecond)
+		}
+		err := store.UpdateAuthor(ctx, Author{ID: ids[0], Name: "Johnny Doe"}, UpdateOptions{})
+		assert.NoError(t, err)
+
+		expected := map[Order][]string{
+			OrderByCreatedAt: {"Johnny Doe", "Jane Doe", "Mary Major"},
+			OrderByUpdatedAt: {"Jane Doe", "Mary Major", "Johnny Doe"},
+		}
+		for order, names := range expected {
+			var listed []string
+			options := ListOptions{PageSize: 2, OrderBy: order}
+			for {
+				page, err := store.ListAuthors(ctx, options)
+				assert.NoError(t, err)
+				for _, author := range page.Authors {
+					listed = append(listed, author.Name)
+				}
+				if page.NextPageToken == "" {
+					break
+				}
+				options.PageToken = page.NextPageToken
+			}
+			assert.Equal(t, names, listed, "order %d", order)
+		}
+	})
+
+	t.Run("ListAuthorsInvalidPageToken", func(t *testing.T) {
+		store := newStore(t)
+		_, err := store.ListAuthors(context.Background(), ListOptions{PageToken: "invalid"})
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+
+	t.Run("UpdateAuthor", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		picURL := "newPicUrl"
+		err = store.UpdateAuthor(ctx, Author{ID: id, PicURL: &picURL}, UpdateOptions{})
+		assert.NoError(t, err)
+		author, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", author.Name)
+		assert.Equal(t, picURL, *author.PicURL)
+	})
+
+	t.Run("UpdateAuthorWithFields", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		picURL := "https://example.com/johndoe.png"
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe", PicURL: &picURL})
+		assert.NoError(t, err)
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe"}, UpdateOptions{Fields: []Field{FieldPicURL}})
+		assert.NoError(t, err)
+		author, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", author.Name, "fields not listed must keep their value")
+		assert.Nil(t, author.PicURL, "fields listed without value must be cleared")
+
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe", PicURL: &picURL},
+			UpdateOptions{Fields: []Field{FieldName, FieldPicURL}})
+		assert.NoError(t, err)
+		author, err = store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", author.Name)
+		assert.Equal(t, picURL, *author.PicURL)
+	})
+
+	t.Run("UpdateAuthorIncrementsVersion", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe", Version: 10})
+		assert.NoError(t, err)
+		author, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), author.Version)
+
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe"}, UpdateOptions{ExpectedVersion: 1})
+		assert.NoError(t, err)
+		author, err = store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), author.Version)
+
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Mary Major"}, UpdateOptions{ExpectedVersion: 1})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+		author, err = store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", author.Name, "conflicting updates must not be applied")
+
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Mary Major"}, UpdateOptions{})
+		assert.NoError(t, err)
+		author, err = store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), author.Version)
+	})
+
+	t.Run("UpdateWithoutUUID", func(t *testing.T) {
+		store := newStore(t)
+		err := store.UpdateAuthor(context.Background(), Author{Name: "John Doe"}, UpdateOptions{})
+		assert.ErrorIs(t, err, ErrMissingID)
+	})
+
+	t.Run("UpdateNonExistentAuthor", func(t *testing.T) {
+		store := newStore(t)
+		newUUID := uuid.New()
+		err := store.UpdateAuthor(context.Background(), Author{ID: &newUUID, Name: "John Doe"}, UpdateOptions{})
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+	})
+
+	t.Run("DeleteAuthorWithVersion", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{ExpectedVersion: 2})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{ExpectedVersion: 1})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{ExpectedVersion: 1})
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+	})
+
+	t.Run("DeleteAuthor", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+		_, err = store.GetAuthor(ctx, id.String())
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+	})
+
+	t.Run("DeleteInvalidUUID", func(t *testing.T) {
+		store := newStore(t)
+		err := store.DeleteAuthor(context.Background(), "Invalid", DeleteOptions{})
+		assert.ErrorIs(t, err, ErrInvalidUUID)
+	})
+
+	t.Run("DeleteNonExistentAuthor", func(t *testing.T) {
+		store := newStore(t)
+		err := store.DeleteAuthor(context.Background(), uuid.NewString(), DeleteOptions{})
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+	})
+
+	t.Run("DeletedAuthorsAreHidden", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		_, err = store.AddAuthor(ctx, Author{Name: "Jane Doe"})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Johnny Doe"}, UpdateOptions{})
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+		_, err = store.AddAuthor(ctx, Author{ID: id, Name: "John Doe"})
+		assert.ErrorIs(t, err, ErrAuthorAlreadyExists)
+		page, err := store.ListAuthors(ctx, ListOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 1)
+		page, err = store.ListAuthors(ctx, ListOptions{IncludeDeleted: true})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 2)
+	})
+
+	t.Run("RestoreAuthor", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+		err = store.RestoreAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		author, err := store.GetAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		assert.False(t, author.DeletedAt.Valid)
+		assert.NoError(t, store.RestoreAuthor(ctx, id.String()), "restoring an active author does nothing")
+		assert.ErrorIs(t, store.RestoreAuthor(ctx, uuid.NewString()), ErrAuthorNotFound)
+		assert.ErrorIs(t, store.RestoreAuthor(ctx, "Invalid"), ErrInvalidUUID)
+	})
+
+	t.Run("PurgeAuthors", func(t *testing.T) {
+		store := newStore(t)
+		ctx := context.Background()
+		deleted, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		active, err := store.AddAuthor(ctx, Author{Name: "Jane Doe"})
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, deleted.String(), DeleteOptions{})
+		assert.NoError(t, err)
+
+		purged, err := store.PurgeAuthors(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Zero(t, purged, "authors deleted after the cutoff must be kept")
+		purged, err = store.PurgeAuthors(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+		assert.ErrorIs(t, store.RestoreAuthor(ctx, deleted.String()), ErrAuthorNotFound)
+		_, err = store.GetAuthor(ctx, active.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetAuthorHistory", func(t *testing.T) {
+		store := newStore(t)
+		ctx := metadata.WithCorrelationID(metadata.WithActor(context.Background(), "editor"), "42")
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe"}, UpdateOptions{})
+		assert.NoError(t, err)
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Mary Major"}, UpdateOptions{ExpectedVersion: 1})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+		err = store.RestoreAuthor(ctx, id.String())
+		assert.NoError(t, err)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+		_, err = store.PurgeAuthors(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		history, err := store.GetAuthorHistory(context.Background(), id.String())
+		assert.NoError(t, err)
+		var operations []AuditOperation
+		for i, entry := range history {
+			operations = append(operations, entry.Operation)
+			assert.Equal(t, *id, entry.AuthorID)
+			assert.Equal(t, "42", entry.CorrelationID)
+			assert.Equal(t, "editor", entry.Actor)
+			assert.False(t, entry.CreatedAt.IsZero())
+			if i > 0 {
+				assert.Greater(t, entry.ID, history[i-1].ID)
+			}
+		}
+		assert.Equal(t, []AuditOperation{AuditCreate, AuditUpdate, AuditDelete, AuditRestore, AuditDelete, AuditPurge},
+			operations)
+		assert.Nil(t, history[0].Before)
+		assert.Equal(t, "John Doe", history[0].After.Name)
+		assert.Equal(t, "John Doe", history[1].Before.Name)
+		assert.Equal(t, "Jane Doe", history[1].After.Name)
+		assert.Equal(t, uint64(2), history[1].After.Version)
+		assert.True(t, history[2].After.DeletedAt.Valid)
+		assert.False(t, history[3].After.DeletedAt.Valid)
+		assert.Equal(t, "Jane Doe", history[5].Before.Name)
+		assert.Nil(t, history[5].After)
+	})
+
+	t.Run("GetAuthorHistoryWithoutChanges", func(t *testing.T) {
+		store := newStore(t)
+		_, err := store.GetAuthorHistory(context.Background(), uuid.NewString())
+		assert.ErrorIs(t, err, ErrAuthorNotFound)
+		_, err = store.GetAuthorHistory(context.Background(), "Invalid")
+		assert.ErrorIs(t, err, ErrInvalidUUID)
+	})
+
+	t.Run("Outbox", func(t *testing.T) {
+		store := newStore(t)
+		outbox, ok := store.(Outbox)
+		if !ok {
+			t.Skip("store has no outbox")
+		}
+		ctx := metadata.WithCorrelationID(context.Background(), "42")
+		id, err := store.AddAuthor(ctx, Author{Name: "John Doe"})
+		assert.NoError(t, err)
+		err = store.UpdateAuthor(ctx, Author{ID: id, Name: "Jane Doe"}, UpdateOptions{ExpectedVersion: 2})
+		assert.ErrorIs(t, err, ErrVersionConflict)
+		err = store.DeleteAuthor(ctx, id.String(), DeleteOptions{})
+		assert.NoError(t, err)
+		_, err = store.PurgeAuthors(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		messages, err := outbox.PendingOutboxMessages(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2, "only committed changes are notified and purges aren't")
+		history, err := store.GetAuthorHistory(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, history[0].ID, messages[0].AuditEntryID)
+		assert.Equal(t, history[1].ID, messages[1].AuditEntryID)
+		assert.Greater(t, messages[1].ID, messages[0].ID)
+		assert.Equal(t, *id, messages[0].AuthorID)
+		assert.NotEmpty(t, messages[0].Payload)
+
+		assert.NoError(t, outbox.MarkOutboxMessageFailed(ctx, messages[0].ID, errors.New("broker unavailable")))
+		limited, err := outbox.PendingOutboxMessages(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, limited, 1)
+		assert.Equal(t, 1, limited[0].Attempts)
+		assert.Equal(t, "broker unavailable", limited[0].LastError)
+
+		assert.NoError(t, outbox.MarkOutboxMessageSent(ctx, messages[0].ID))
+		pending, err := outbox.PendingOutboxMessages(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		assert.Equal(t, messages[1].ID, pending[0].ID)
+
+		err = outbox.ClaimOutboxMessages(ctx, 10, func(claimed Outbox, claimedMessages []OutboxMessage) error {
+			assert.Len(t, claimedMessages, 1)
+			assert.Equal(t, messages[1].ID, claimedMessages[0].ID)
+			return claimed.MarkOutboxMessageSent(ctx, claimedMessages[0].ID)
+		})
+		assert.NoError(t, err)
+		pending, err = outbox.PendingOutboxMessages(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, pending, "the marks made on the claim are kept")
+
+		purged, err := outbox.PurgeOutboxMessages(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Zero(t, purged)
+		_, err = store.AddAuthor(ctx, Author{Name: "Jane Doe"})
+		assert.NoError(t, err)
+		purged, err = outbox.PurgeOutboxMessages(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged, "only the sent messages are purged")
+		pending, err = outbox.PendingOutboxMessages(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		assert.Greater(t, pending[0].ID, messages[1].ID)
+	})
+
+	t.Run("ProcessedMessages", func(t *testing.T) {
+		store := newStore(t)
+		idempotency, ok := store.(IdempotencyStore)
+		if !ok {
+			t.Skip("store has no idempotency store")
+		}
+		ctx := context.Background()
+		processed, err := idempotency.GetProcessedMessage(ctx, "message-1", "create")
+		assert.NoError(t, err)
+		assert.Nil(t, processed)
+
+		keyed := metadata.WithIdempotencyKey(ctx, "message-1", "create")
+		_, err = store.AddAuthor(keyed, Author{Name: "Jose Saramago"})
+		assert.NoError(t, err)
+		id, err := store.AddAuthor(keyed, Author{Name: "Clarice Lispector"})
+		assert.Equal(t, ErrAlreadyProcessed, err, "the message changes an author once")
+		assert.Nil(t, id)
+		page, err := store.ListAuthors(ctx, ListOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Authors, 1, "the refused change is rolled back")
+		processed, err = idempotency.GetProcessedMessage(ctx, "message-1", "create")
+		assert.NoError(t, err)
+		assert.Equal(t, *page.Authors[0].ID, processed.AuthorID)
+		assert.False(t, processed.CreatedAt.IsZero())
+
+		reused := metadata.WithIdempotencyKey(ctx, "message-1", "delete")
+		assert.NoError(t, store.DeleteAuthor(reused, page.Authors[0].ID.String(), DeleteOptions{}),
+			"messages reusing the key with another fingerprint are processed")
+		processed, err = idempotency.GetProcessedMessage(ctx, "message-1", "delete")
+		assert.NoError(t, err)
+		assert.NotNil(t, processed)
+		err = store.RestoreAuthor(reused, page.Authors[0].ID.String())
+		assert.Equal(t, ErrAlreadyProcessed, err)
+
+		failed := metadata.WithIdempotencyKey(ctx, "message-2", "delete")
+		err = store.DeleteAuthor(failed, uuid.NewString(), DeleteOptions{})
+		assert.Equal(t, ErrAuthorNotFound, err)
+		processed, err = idempotency.GetProcessedMessage(ctx, "message-2", "delete")
+		assert.NoError(t, err)
+		assert.Nil(t, processed, "failed changes aren't recorded")
+
+		purged, err := idempotency.PurgeProcessedMessages(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), purged)
+		purged, err = idempotency.PurgeProcessedMessages(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		processed, err = idempotency.GetProcessedMessage(ctx, "message-1", "create")
+		assert.NoError(t, err)
+		assert.Nil(t, processed)
+	})
+}
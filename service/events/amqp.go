@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"service/metadata"
+	"service/utils"
+	"strconv"
+
+	"github.com/streadway/amqp"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentType Content type of the published changes, the binary encoding of AuthorChanged.
+const ContentType = "application/x-protobuf"
+
+// Channel Part of the amqp.Channel used to publish changes.
+type Channel interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+}
+
+// ErrNotConfirmed Returned when the broker doesn't take responsibility for a published change.
+var ErrNotConfirmed = errors.New("change not confirmed by the broker")
+
+// AMQPPublisher Publisher sending the changes to an AMQP topic exchange, routed by RoutingKey.
+type AMQPPublisher struct {
+	channel  Channel
+	exchange string
+	// confirmations Receives the broker confirmations when the channel is in confirm mode.
+	confirmations <-chan amqp.Confirmation
+}
+
+var _ Publisher = (*AMQPPublisher)(nil)
+
+// NewAMQPPublisher Creates a new AMQPPublisher sending the changes to the exchange through the
+// channel. The exchange must already be declared.
+func NewAMQPPublisher(channel Channel, exchange string) *AMQPPublisher {
+	return &AMQPPublisher{
+		channel:  channel,
+		exchange: exchange,
+	}
+}
+
+// EnableConfirms Puts the channel, which must be the one the publisher sends through, in confirm
+// mode, so Publish waits until the broker takes responsibility for each change. Publish must then
+// not be called concurrently.
+func (publisher *AMQPPublisher) EnableConfirms(channel *amqp.Channel) error {
+	if err := channel.Confirm(false); err != nil {
+		return err
+	}
+	publisher.confirmations = channel.NotifyPublish(make(chan amqp.Confirmation, 1))
+	return nil
+}
+
+// DeclareExchange Declares the durable topic exchange the changes are published to.
+func DeclareExchange(channel *amqp.Channel, exchange string) error {
+	return channel.ExchangeDeclare(
+		exchange, // name
+		"topic",  // kind
+		true,     // durable
+		false,    // auto-deleted
+		false,    // internal
+		false,    // no-wait
+		nil,      // arguments
+	)
+}
+
+// Publish Sends the change as a persistent message, using its sequence as the message id.
+func (publisher *AMQPPublisher) Publish(ctx context.Context, change *authorManagementProto.AuthorChanged) error {
+	err := publisher.channel.Publish(
+		publisher.exchange, RoutingKey(change),
+		false, // mandatory
+		false, // immediate
+		amqp.Publishing{
+			Headers: amqp.Table{
+				metadata.ActorHeader: change.Actor,
+			},
+			ContentType:   ContentType,
+			DeliveryMode:  amqp.Persistent,
+			CorrelationId: change.CorrelationId,
+			MessageId:     strconv.FormatUint(change.Sequence, 10),
+			Timestamp:     change.OccurredAt.AsTime(),
+			Type:          string(proto.MessageName(change)),
+			Body:          utils.EncodeAuthorChangedToByte(change),
+		})
+	if err != nil || publisher.confirmations == nil {
+		return err
+	}
+	select {
+	case confirmation, ok := <-publisher.confirmations:
+		if !ok || !confirmation.Ack {
+			return ErrNotConfirmed
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
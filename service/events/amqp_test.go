@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+// recordingChannel Channel keeping the messages published through it.
+type recordingChannel struct {
+	exchange string
+	key      string
+	message  amqp.Publishing
+	err      error
+}
+
+func (channel *recordingChannel) Publish(exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	channel.exchange = exchange
+	channel.key = key
+	channel.message = msg
+	return channel.err
+}
+
+func TestAMQPPublisher_Publish(t *testing.T) {
+	channel := &recordingChannel{}
+	publisher := NewAMQPPublisher(channel, "authorEvents")
+	occurredAt := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	change := &authorManagementProto.AuthorChanged{
+		Type:          authorManagementProto.AuthorChanged_UPDATED,
+		Uuid:          "8f8e3a5e-7a4b-4b4e-9f0c-0a4c2e9f5b1d",
+		Author:        &authorManagementProto.Author{Name: "John Doe", Version: 2},
+		Sequence:      42,
+		OccurredAt:    timestamppb.New(occurredAt),
+		CorrelationId: "request-1",
+		Actor:         "editor",
+	}
+
+	assert.NoError(t, publisher.Publish(context.Background(), change))
+	assert.Equal(t, "authorEvents", channel.exchange)
+	assert.Equal(t, "author.updated", channel.key)
+	assert.Equal(t, "42", channel.message.MessageId)
+	assert.Equal(t, "request-1", channel.message.CorrelationId)
+	assert.Equal(t, "editor", channel.message.Headers["x-actor"])
+	assert.Equal(t, ContentType, channel.message.ContentType)
+	assert.Equal(t, "org.wcode.proto.authormanagement.AuthorChanged", channel.message.Type)
+	assert.Equal(t, amqp.Persistent, channel.message.DeliveryMode)
+	assert.True(t, occurredAt.Equal(channel.message.Timestamp))
+	published := &authorManagementProto.AuthorChanged{}
+	assert.NoError(t, proto.Unmarshal(channel.message.Body, published))
+	assert.Equal(t, "John Doe", published.Author.Name)
+	assert.Equal(t, uint64(42), published.Sequence)
+}
+
+func TestAMQPPublisher_PublishError(t *testing.T) {
+	channel := &recordingChannel{err: errors.New("channel closed")}
+	publisher := NewAMQPPublisher(channel, "authorEvents")
+	err := publisher.Publish(context.Background(), &authorManagementProto.AuthorChanged{})
+	assert.EqualError(t, err, "channel closed")
+}
+
+func TestAMQPPublisher_PublishWithConfirms(t *testing.T) {
+	confirmations := make(chan amqp.Confirmation, 1)
+	publisher := NewAMQPPublisher(&recordingChannel{}, "authorEvents")
+	publisher.confirmations = confirmations
+
+	confirmations <- amqp.Confirmation{DeliveryTag: 1, Ack: true}
+	assert.NoError(t, publisher.Publish(context.Background(), &authorManagementProto.AuthorChanged{}))
+	confirmations <- amqp.Confirmation{DeliveryTag: 2, Ack: false}
+	assert.ErrorIs(t, publisher.Publish(context.Background(), &authorManagementProto.AuthorChanged{}), ErrNotConfirmed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, publisher.Publish(ctx, &authorManagementProto.AuthorChanged{}), context.Canceled)
+	close(confirmations)
+	assert.ErrorIs(t, publisher.Publish(context.Background(), &authorManagementProto.AuthorChanged{}), ErrNotConfirmed)
+}
+
+func TestRoutingKey(t *testing.T) {
+	assert.Equal(t, "author.created", RoutingKey(&authorManagementProto.AuthorChanged{}))
+	assert.Equal(t, "author.restored", RoutingKey(&authorManagementProto.AuthorChanged{
+		Type: authorManagementProto.AuthorChanged_RESTORED,
+	}))
+}
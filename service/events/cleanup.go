@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"service/database"
+	"time"
+)
+
+const (
+	// DefaultOutboxRetention Time sent outbox messages are kept by default.
+	DefaultOutboxRetention = 24 * time.Hour
+	// DefaultCleanupInterval Time between cleanups of the outbox by default.
+	DefaultCleanupInterval = time.Hour
+)
+
+// Cleanup Background job deleting the outbox messages sent longer than the retention period ago,
+// so the outbox doesn't grow forever.
+type Cleanup struct {
+	outbox    database.Outbox
+	retention time.Duration
+	interval  time.Duration
+	logger    *slog.Logger
+}
+
+// NewCleanup Creates a new Cleanup deleting the messages of the outbox sent longer than retention
+// ago, once every interval, and logging each cleanup to logger.
+func NewCleanup(outbox database.Outbox, retention time.Duration, interval time.Duration,
+	logger *slog.Logger) *Cleanup {
+	return &Cleanup{
+		outbox:    outbox,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// RunOnce Deletes the messages sent longer than the retention period ago, returning how many were
+// deleted.
+func (cleanup *Cleanup) RunOnce(ctx context.Context) (int64, error) {
+	return cleanup.outbox.PurgeOutboxMessages(ctx, time.Now().Add(-cleanup.retention))
+}
+
+// Run Deletes the expired sent messages right away and then once every interval, until ctx is done.
+func (cleanup *Cleanup) Run(ctx context.Context) {
+	ticker := time.NewTicker(cleanup.interval)
+	defer ticker.Stop()
+	for {
+		purged, err := cleanup.RunOnce(ctx)
+		if err != nil {
+			cleanup.logger.ErrorContext(ctx, "Failed to delete the sent outbox messages", "error", err)
+		} else if purged > 0 {
+			cleanup.logger.InfoContext(ctx, "Deleted the sent outbox messages", "count", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
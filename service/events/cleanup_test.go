@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"service/database"
+	"testing"
+	"time"
+)
+
+func TestCleanup_RunOnce(t *testing.T) {
+	store := database.NewMemoryStore()
+	ctx := context.Background()
+	_, err := store.AddAuthor(ctx, database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	_, err = NewRelay(store, &recordingPublisher{}, time.Hour, slog.Default()).RunOnce(ctx)
+	assert.NoError(t, err)
+	_, err = store.AddAuthor(ctx, database.Author{Name: "Jane Doe"})
+	assert.NoError(t, err)
+
+	purged, err := NewCleanup(store, time.Hour, time.Hour, slog.Default()).RunOnce(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, purged, "recently sent messages are kept")
+
+	purged, err = NewCleanup(store, -time.Second, time.Hour, slog.Default()).RunOnce(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	pending, err := store.PendingOutboxMessages(ctx, 10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1, "pending messages are never deleted")
+}
@@ -0,0 +1,20 @@
+package events
+
+import (
+	"context"
+	"strings"
+
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+)
+
+// Publisher Notifies other services of the changes made to authors.
+type Publisher interface {
+	// Publish Sends the change to the services listening for it.
+	Publish(ctx context.Context, change *authorManagementProto.AuthorChanged) error
+}
+
+// RoutingKey Returns the key the change is routed with, author followed by the type of change, as
+// in author.created, so consumers can bind to the changes they need.
+func RoutingKey(change *authorManagementProto.AuthorChanged) string {
+	return "author." + strings.ToLower(change.Type.String())
+}
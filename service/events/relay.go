@@ -0,0 +1,104 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"service/database"
+	"time"
+
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultRelayInterval Time between polls of the outbox by default.
+	DefaultRelayInterval = time.Second
+	// DefaultRelayBatchSize Maximum number of messages published on each poll of the outbox by
+	// default.
+	DefaultRelayBatchSize = 100
+)
+
+// Relay Background job publishing the change notifications written to the outbox, in order, and
+// marking them sent.
+type Relay struct {
+	outbox    database.Outbox
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+// NewRelay Creates a new Relay publishing the pending messages of the outbox through the
+// publisher, polling it once every interval, and logging the failures to logger.
+func NewRelay(outbox database.Outbox, publisher Publisher, interval time.Duration, logger *slog.Logger) *Relay {
+	return &Relay{
+		outbox:    outbox,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: DefaultRelayBatchSize,
+		logger:    logger,
+	}
+}
+
+// RunOnce Publishes a batch of pending messages, returning how many were sent. The batch is claimed
+// on the outbox, so the relays of other replicas skip it. Stops at the first message that fails to
+// publish, recording the attempt, so it is retried before the ones after it. A message published
+// but not marked sent is published again.
+func (relay *Relay) RunOnce(ctx context.Context) (int, error) {
+	sent := 0
+	var publishErr error
+	err := relay.outbox.ClaimOutboxMessages(ctx, relay.batchSize,
+		func(outbox database.Outbox, messages []database.OutboxMessage) error {
+			for _, message := range messages {
+				if publishErr = relay.publish(ctx, message); publishErr != nil {
+					// The claim succeeds, so the messages sent before and the attempt are kept.
+					if err := outbox.MarkOutboxMessageFailed(ctx, message.ID, publishErr); err != nil {
+						relay.logger.ErrorContext(ctx, "Failed to record the failed attempt of an outbox message",
+							"id", message.ID, "error", err)
+					}
+					return nil
+				}
+				if err := outbox.MarkOutboxMessageSent(ctx, message.ID); err != nil {
+					return err
+				}
+				sent++
+			}
+			return nil
+		})
+	if err != nil {
+		// The marks were rolled back, so the batch is published again.
+		return 0, err
+	}
+	return sent, publishErr
+}
+
+// publish Decodes the notification of the message and publishes it.
+func (relay *Relay) publish(ctx context.Context, message database.OutboxMessage) error {
+	change := &authorManagementProto.AuthorChanged{}
+	if err := proto.Unmarshal(message.Payload, change); err != nil {
+		return err
+	}
+	return relay.publisher.Publish(ctx, change)
+}
+
+// Run Publishes the pending messages right away and then once every interval, until ctx is done.
+// Full batches are followed by the next one without waiting, and failed ones are retried on the
+// next run.
+func (relay *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+	for {
+		sent, err := relay.RunOnce(ctx)
+		if err != nil {
+			relay.logger.WarnContext(ctx, "Failed to publish the outbox", "error", err)
+		}
+		if err == nil && sent == relay.batchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"log/slog"
+	"service/database"
+	"service/metadata"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingPublisher Publisher keeping the changes published through it, failing while err is set.
+type recordingPublisher struct {
+	mutex   sync.Mutex
+	changes []*authorManagementProto.AuthorChanged
+	err     error
+}
+
+func (publisher *recordingPublisher) Publish(_ context.Context, change *authorManagementProto.AuthorChanged) error {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	if publisher.err != nil {
+		return publisher.err
+	}
+	publisher.changes = append(publisher.changes, change)
+	return nil
+}
+
+// published Returns the changes published so far.
+func (publisher *recordingPublisher) published() []*authorManagementProto.AuthorChanged {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	return append([]*authorManagementProto.AuthorChanged(nil), publisher.changes...)
+}
+
+func TestRelay_RunOnce(t *testing.T) {
+	store := database.NewMemoryStore()
+	ctx := metadata.WithCorrelationID(metadata.WithActor(context.Background(), "editor"), "42")
+	id, err := store.AddAuthor(ctx, database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateAuthor(ctx, database.Author{ID: id, Name: "Jane Doe"}, database.UpdateOptions{}))
+	assert.NoError(t, store.DeleteAuthor(ctx, id.String(), database.DeleteOptions{}))
+	assert.NoError(t, store.RestoreAuthor(ctx, id.String()))
+	publisher := &recordingPublisher{}
+	relay := NewRelay(store, publisher, time.Hour, slog.Default())
+
+	sent, err := relay.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, sent)
+	var types []authorManagementProto.AuthorChanged_Type
+	for i, change := range publisher.published() {
+		types = append(types, change.Type)
+		assert.Equal(t, id.String(), change.Uuid)
+		assert.Equal(t, "42", change.CorrelationId)
+		assert.Equal(t, "editor", change.Actor)
+		if i > 0 {
+			assert.Greater(t, change.Sequence, publisher.changes[i-1].Sequence)
+		}
+	}
+	assert.Equal(t, []authorManagementProto.AuthorChanged_Type{
+		authorManagementProto.AuthorChanged_CREATED,
+		authorManagementProto.AuthorChanged_UPDATED,
+		authorManagementProto.AuthorChanged_DELETED,
+		authorManagementProto.AuthorChanged_RESTORED,
+	}, types)
+	assert.Equal(t, "Jane Doe", publisher.changes[1].Author.Name)
+	assert.NotNil(t, publisher.changes[2].Author.DeletedAt)
+
+	sent, err = relay.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, sent, "sent messages aren't published again")
+}
+
+func TestRelay_RunOnceRetriesFailures(t *testing.T) {
+	store := database.NewMemoryStore()
+	_, err := store.AddAuthor(context.Background(), database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	publisher := &recordingPublisher{err: errors.New("broker unavailable")}
+	relay := NewRelay(store, publisher, time.Hour, slog.Default())
+
+	sent, err := relay.RunOnce(context.Background())
+	assert.EqualError(t, err, "broker unavailable")
+	assert.Zero(t, sent)
+	pending, err := store.PendingOutboxMessages(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Equal(t, "broker unavailable", pending[0].LastError)
+
+	publisher.err = nil
+	sent, err = relay.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Len(t, publisher.published(), 1)
+}
+
+func TestRelay_RunUntilCancelled(t *testing.T) {
+	store := database.NewMemoryStore()
+	publisher := &recordingPublisher{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewRelay(store, publisher, time.Millisecond, slog.Default()).Run(ctx)
+		close(done)
+	}()
+
+	_, err := store.AddAuthor(context.Background(), database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(publisher.published()) == 1
+	}, time.Second, time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("relay didn't stop after the context was cancelled")
+	}
+}
+
+// claimingPublisher Publisher running another relay on the same outbox while publishing each change.
+type claimingPublisher struct {
+	recordingPublisher
+	other *Relay
+	sent  []int
+}
+
+func (publisher *claimingPublisher) Publish(ctx context.Context, change *authorManagementProto.AuthorChanged) error {
+	sent, err := publisher.other.RunOnce(ctx)
+	if err != nil {
+		return err
+	}
+	publisher.sent = append(publisher.sent, sent)
+	return publisher.recordingPublisher.Publish(ctx, change)
+}
+
+func TestRelay_RunOnceSkipsClaimedMessages(t *testing.T) {
+	store := database.NewMemoryStore()
+	_, err := store.AddAuthor(context.Background(), database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	_, err = store.AddAuthor(context.Background(), database.Author{Name: "Jane Doe"})
+	assert.NoError(t, err)
+	other := &recordingPublisher{}
+	publisher := &claimingPublisher{other: NewRelay(store, other, time.Hour, slog.Default())}
+
+	sent, err := NewRelay(store, publisher, time.Hour, slog.Default()).RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, []int{0, 0}, publisher.sent, "the claimed messages are skipped by the other relay")
+	assert.Empty(t, other.published())
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Activity Tracks the progress of the messages being processed, to detect a consumer that stopped
+// making progress.
+type Activity struct {
+	inFlight     int64
+	lastProgress int64
+}
+
+// NewActivity Creates a new Activity without messages in progress.
+func NewActivity() *Activity {
+	return &Activity{lastProgress: time.Now().UnixNano()}
+}
+
+// Begin Records that a message started being processed.
+func (activity *Activity) Begin() {
+	if atomic.AddInt64(&activity.inFlight, 1) == 1 {
+		atomic.StoreInt64(&activity.lastProgress, time.Now().UnixNano())
+	}
+}
+
+// End Records that a message finished being processed.
+func (activity *Activity) End() {
+	atomic.StoreInt64(&activity.lastProgress, time.Now().UnixNano())
+	atomic.AddInt64(&activity.inFlight, -1)
+}
+
+// Stalled Returns a Check failing when messages are in progress but none finished for longer than
+// timeout.
+func (activity *Activity) Stalled(timeout time.Duration) Check {
+	return func(context.Context) error {
+		if atomic.LoadInt64(&activity.inFlight) == 0 {
+			return nil
+		}
+		idle := time.Since(time.Unix(0, atomic.LoadInt64(&activity.lastProgress)))
+		if idle > timeout {
+			return fmt.Errorf("no message finished processing for %s", idle.Round(time.Second))
+		}
+		return nil
+	}
+}
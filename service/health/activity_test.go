@@ -0,0 +1,26 @@
+package health
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestActivity_Stalled(t *testing.T) {
+	activity := NewActivity()
+	stalled := activity.Stalled(10 * time.Millisecond)
+	assert.NoError(t, stalled(context.Background()))
+
+	activity.Begin()
+	assert.NoError(t, stalled(context.Background()))
+	time.Sleep(20 * time.Millisecond)
+	assert.Error(t, stalled(context.Background()), "a message is taking longer than the timeout")
+
+	activity.Begin()
+	activity.End()
+	assert.NoError(t, stalled(context.Background()), "another message finished")
+	activity.End()
+	time.Sleep(20 * time.Millisecond)
+	assert.NoError(t, stalled(context.Background()), "idle consumers aren't stalled")
+}
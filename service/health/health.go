@@ -0,0 +1,133 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout Time each check is given to complete.
+const checkTimeout = 2 * time.Second
+
+// ErrNotReady Reported while the service is starting or shutting down.
+var ErrNotReady = errors.New("service is starting or shutting down")
+
+// Check Verifies a dependency or component of the service, returning why it is unhealthy.
+type Check func(ctx context.Context) error
+
+// namedCheck Check with the name it is reported with.
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker Reports the liveness and readiness of the service on the /healthz and /readyz
+// endpoints. The service is not ready until SetReady is called, nor after SetReady(false).
+type Checker struct {
+	ready     int32
+	mutex     sync.RWMutex
+	liveness  []namedCheck
+	readiness []namedCheck
+}
+
+// NewChecker Creates a new Checker without checks, not ready.
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// SetReady Sets whether the service finished starting and isn't shutting down.
+func (checker *Checker) SetReady(ready bool) {
+	value := int32(0)
+	if ready {
+		value = 1
+	}
+	atomic.StoreInt32(&checker.ready, value)
+}
+
+// AddLiveness Adds a check that makes the service be restarted while failing. Must only fail
+// when restarting fixes it.
+func (checker *Checker) AddLiveness(name string, check Check) {
+	checker.mutex.Lock()
+	defer checker.mutex.Unlock()
+	checker.liveness = append(checker.liveness, namedCheck{name, check})
+}
+
+// AddReadiness Adds a check that stops traffic from being sent to the service while failing.
+func (checker *Checker) AddReadiness(name string, check Check) {
+	checker.mutex.Lock()
+	defer checker.mutex.Unlock()
+	checker.readiness = append(checker.readiness, namedCheck{name, check})
+}
+
+// Register Adds the /healthz and /readyz endpoints to the mux.
+func (checker *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", checker.handleLiveness)
+	mux.HandleFunc("/readyz", checker.handleReadiness)
+}
+
+// report JSON body describing the result of the checks.
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// handleLiveness Replies whether every liveness check passes.
+func (checker *Checker) handleLiveness(writer http.ResponseWriter, request *http.Request) {
+	checker.mutex.RLock()
+	checks := checker.liveness
+	checker.mutex.RUnlock()
+	writeReport(writer, run(request.Context(), checks))
+}
+
+// handleReadiness Replies whether the service is ready and every readiness check passes.
+func (checker *Checker) handleReadiness(writer http.ResponseWriter, request *http.Request) {
+	checker.mutex.RLock()
+	checks := checker.readiness
+	checker.mutex.RUnlock()
+	if atomic.LoadInt32(&checker.ready) == 0 {
+		checks = append([]namedCheck{{"ready", func(context.Context) error { return ErrNotReady }}}, checks...)
+	}
+	writeReport(writer, run(request.Context(), checks))
+}
+
+// run Runs the checks concurrently, returning the report of their results.
+func run(ctx context.Context, checks []namedCheck) report {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	results := make([]error, len(checks))
+	var waitGroup sync.WaitGroup
+	for i, check := range checks {
+		waitGroup.Add(1)
+		go func(i int, check Check) {
+			defer waitGroup.Done()
+			results[i] = check(ctx)
+		}(i, check.check)
+	}
+	waitGroup.Wait()
+	result := report{Status: "ok", Checks: map[string]string{}}
+	for i, err := range results {
+		if err != nil {
+			result.Status = "unavailable"
+			result.Checks[checks[i].name] = err.Error()
+		} else {
+			result.Checks[checks[i].name] = "ok"
+		}
+	}
+	return result
+}
+
+// writeReport Writes the report as JSON, with status 503 when a check failed.
+func writeReport(writer http.ResponseWriter, result report) {
+	status := http.StatusOK
+	if result.Status != "ok" {
+		status = http.StatusServiceUnavailable
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(result)
+}
@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// get Sends a GET request to the checker endpoint and decodes its report.
+func get(t *testing.T, checker *Checker, path string) (int, report) {
+	mux := http.NewServeMux()
+	checker.Register(mux)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	var result report
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&result))
+	return recorder.Code, result
+}
+
+func TestChecker_Readiness(t *testing.T) {
+	checker := NewChecker()
+	failing := errors.New("connection refused")
+	var databaseErr error
+	checker.AddReadiness("database", func(context.Context) error { return databaseErr })
+
+	status, result := get(t, checker, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, status, "not ready until SetReady is called")
+	assert.Equal(t, ErrNotReady.Error(), result.Checks["ready"])
+
+	checker.SetReady(true)
+	status, result = get(t, checker, "/readyz")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, report{Status: "ok", Checks: map[string]string{"database": "ok"}}, result)
+
+	databaseErr = failing
+	status, result = get(t, checker, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, "unavailable", result.Status)
+	assert.Equal(t, "connection refused", result.Checks["database"])
+
+	databaseErr = nil
+	checker.SetReady(false)
+	status, _ = get(t, checker, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, status, "not ready while shutting down")
+}
+
+func TestChecker_Liveness(t *testing.T) {
+	checker := NewChecker()
+	status, result := get(t, checker, "/healthz")
+	assert.Equal(t, http.StatusOK, status, "alive while starting")
+	assert.Equal(t, "ok", result.Status)
+
+	checker.AddLiveness("consumer", func(context.Context) error { return errors.New("stalled") })
+	checker.AddReadiness("database", func(context.Context) error { return errors.New("unreachable") })
+	status, result = get(t, checker, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, map[string]string{"consumer": "stalled"}, result.Checks, "readiness checks aren't run")
+}
@@ -0,0 +1,108 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"service/database"
+	"service/metadata"
+	"time"
+)
+
+const (
+	// DefaultTTL Time the processed messages are kept by default.
+	DefaultTTL = 24 * time.Hour
+	// DefaultInterval Time between cleanups of the expired processed messages by default.
+	DefaultInterval = time.Hour
+)
+
+// Deduplicator Processes each message changing an author once, replaying the response to its
+// redeliveries. Processed messages are kept for ttl.
+type Deduplicator struct {
+	store    database.IdempotencyStore
+	ttl      time.Duration
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewDeduplicator Creates a new Deduplicator keeping the messages processed on the store for ttl,
+// and cleaning up the expired ones once every interval. Replays and failures are logged to logger.
+func NewDeduplicator(store database.IdempotencyStore, ttl time.Duration, interval time.Duration,
+	logger *slog.Logger) *Deduplicator {
+	return &Deduplicator{
+		store:    store,
+		ttl:      ttl,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Process Processes a message changing an author once. The message is identified by its key and
+// the fingerprint of its content, so messages reusing a key with a different content, as the ones
+// of a flow sharing a correlation id, are processed apart. process is called with a copy of ctx
+// carrying both, so the store records the message on the same transaction as the change, and when
+// the message was already processed replay is called instead with the recorded message to build
+// its response again. When process fails nothing is recorded, so the message is processed again
+// when retried. Messages without a key are always processed. Failing to read the recorded message
+// is logged and the message processed anyway, the store refusing it when it was recorded.
+func (deduplicator *Deduplicator) Process(ctx context.Context, key string, content []byte,
+	process func(ctx context.Context) ([]byte, error), replay func(message database.ProcessedMessage) []byte) ([]byte, error) {
+	if key == "" {
+		return process(ctx)
+	}
+	fingerprint := Fingerprint(content)
+	processed, err := deduplicator.store.GetProcessedMessage(ctx, key, fingerprint)
+	if err != nil {
+		deduplicator.logger.ErrorContext(ctx, "Failed to read the processed message", "key", key, "error", err)
+	} else if processed != nil {
+		deduplicator.logger.InfoContext(ctx, "Replaying the response of a message", "key", key)
+		return replay(*processed), nil
+	}
+	response, err := process(metadata.WithIdempotencyKey(ctx, key, fingerprint))
+	if !errors.Is(err, database.ErrAlreadyProcessed) {
+		return response, err
+	}
+	// A redelivery of the message was processed concurrently, or the message couldn't be read.
+	processed, err = deduplicator.store.GetProcessedMessage(ctx, key, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if processed == nil {
+		// Purged in the meantime, so processed long ago.
+		return nil, database.ErrAlreadyProcessed
+	}
+	deduplicator.logger.InfoContext(ctx, "Replaying the response of a message", "key", key)
+	return replay(*processed), nil
+}
+
+// Fingerprint Returns the hex encoded SHA-256 hash of the content of a message.
+func Fingerprint(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// RunOnce Deletes the messages processed longer than ttl ago, returning how many were deleted.
+func (deduplicator *Deduplicator) RunOnce(ctx context.Context) (int64, error) {
+	return deduplicator.store.PurgeProcessedMessages(ctx, time.Now().Add(-deduplicator.ttl))
+}
+
+// Run Deletes the expired processed messages right away and then once every interval, until ctx is done.
+func (deduplicator *Deduplicator) Run(ctx context.Context) {
+	ticker := time.NewTicker(deduplicator.interval)
+	defer ticker.Stop()
+	for {
+		purged, err := deduplicator.RunOnce(ctx)
+		if err != nil {
+			deduplicator.logger.ErrorContext(ctx, "Failed to delete the expired processed messages", "error", err)
+		} else if purged > 0 {
+			deduplicator.logger.InfoContext(ctx, "Deleted the expired processed messages", "count", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"service/database"
+	"testing"
+	"time"
+)
+
+// addAuthor Returns a process function adding an author to the store, responding with its uuid.
+func addAuthor(store *database.MemoryStore, calls *int) func(ctx context.Context) ([]byte, error) {
+	return func(ctx context.Context) ([]byte, error) {
+		*calls++
+		id, err := store.AddAuthor(ctx, database.Author{Name: "Jose Saramago"})
+		if err != nil {
+			return nil, err
+		}
+		return []byte(id.String()), nil
+	}
+}
+
+// content Content of the processed messages.
+var content = []byte("CREATE Jose Saramago")
+
+// replayAuthor Responds to a replayed message with the uuid of the author it changed.
+func replayAuthor(message database.ProcessedMessage) []byte {
+	return []byte(message.AuthorID.String())
+}
+
+func TestDeduplicator_Process(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Hour, time.Hour, slog.Default())
+	ctx := context.Background()
+	calls := 0
+	process := addAuthor(store, &calls)
+
+	first, err := deduplicator.Process(ctx, "message-1", content, process, replayAuthor)
+	assert.NoError(t, err)
+	replayed, err := deduplicator.Process(ctx, "message-1", content, process, replayAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, first, replayed, "redeliveries replay the response")
+	second, err := deduplicator.Process(ctx, "message-2", content, process, replayAuthor)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	_, err = deduplicator.Process(ctx, "", content, process, replayAuthor)
+	assert.NoError(t, err)
+	_, err = deduplicator.Process(ctx, "", content, process, replayAuthor)
+	assert.NoError(t, err, "messages without a key are always processed")
+	assert.Equal(t, 4, calls)
+
+	page, err := store.ListAuthors(ctx, database.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, page.Authors, 4)
+}
+
+func TestDeduplicator_ProcessRecordedOnChange(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Hour, time.Hour, slog.Default())
+	ctx := context.Background()
+	calls := 0
+	process := addAuthor(store, &calls)
+
+	first, err := deduplicator.Process(ctx, "message-1", content, func(ctx context.Context) ([]byte, error) {
+		// Changed, but the response lost, as when the service stops before replying.
+		_, err := process(ctx)
+		return nil, err
+	}, replayAuthor)
+	assert.NoError(t, err)
+	assert.Nil(t, first)
+	processed, err := store.GetProcessedMessage(ctx, "message-1", Fingerprint(content))
+	assert.NoError(t, err)
+	assert.NotNil(t, processed, "the message is recorded along with the change")
+
+	replayed, err := deduplicator.Process(ctx, "message-1", content, process, replayAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(processed.AuthorID.String()), replayed)
+	assert.Equal(t, 1, calls, "the change isn't applied again")
+}
+
+func TestDeduplicator_ProcessConcurrentRedelivery(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Hour, time.Hour, slog.Default())
+	ctx := context.Background()
+	calls := 0
+	process := addAuthor(store, &calls)
+
+	var redelivered []byte
+	response, err := deduplicator.Process(ctx, "message-1", content, func(keyed context.Context) ([]byte, error) {
+		// The redelivery is processed after the first delivery found no recorded message.
+		var err error
+		redelivered, err = deduplicator.Process(ctx, "message-1", content, process, replayAuthor)
+		assert.NoError(t, err)
+		return process(keyed)
+	}, replayAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, redelivered, response, "the store refuses the second change")
+	assert.Equal(t, 2, calls)
+
+	page, err := store.ListAuthors(ctx, database.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, page.Authors, 1)
+}
+
+func TestDeduplicator_ProcessFailure(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Hour, time.Hour, slog.Default())
+	ctx := context.Background()
+	_, err := deduplicator.Process(ctx, "message-1", content, func(context.Context) ([]byte, error) {
+		return nil, errors.New("database unavailable")
+	}, replayAuthor)
+	assert.EqualError(t, err, "database unavailable")
+
+	response, err := deduplicator.Process(ctx, "message-1", content, func(context.Context) ([]byte, error) {
+		return []byte("processed"), nil
+	}, replayAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("processed"), response, "failures aren't replayed")
+}
+
+func TestDeduplicator_RunOnce(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Millisecond, time.Hour, slog.Default())
+	ctx := context.Background()
+	calls := 0
+	_, err := deduplicator.Process(ctx, "message-1", content, addAuthor(store, &calls), replayAuthor)
+	assert.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	purged, err := deduplicator.RunOnce(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	processed, err := store.GetProcessedMessage(ctx, "message-1", Fingerprint(content))
+	assert.NoError(t, err)
+	assert.Nil(t, processed)
+}
+
+func TestDeduplicator_ProcessReusedKey(t *testing.T) {
+	store := database.NewMemoryStore()
+	deduplicator := NewDeduplicator(store, time.Hour, time.Hour, slog.Default())
+	ctx := context.Background()
+	calls := 0
+	process := addAuthor(store, &calls)
+
+	first, err := deduplicator.Process(ctx, "request-1", content, process, replayAuthor)
+	assert.NoError(t, err)
+	second, err := deduplicator.Process(ctx, "request-1", []byte("CREATE Clarice Lispector"), process, replayAuthor)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second, "messages reusing the key with another content aren't replayed")
+	replayed, err := deduplicator.Process(ctx, "request-1", []byte("CREATE Clarice Lispector"), process, replayAuthor)
+	assert.NoError(t, err)
+	assert.Equal(t, second, replayed)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFingerprint(t *testing.T) {
+	assert.Equal(t, Fingerprint([]byte("a")), Fingerprint([]byte("a")))
+	assert.NotEqual(t, Fingerprint([]byte("a")), Fingerprint([]byte("b")))
+	assert.Len(t, Fingerprint(nil), 64)
+}
@@ -0,0 +1,113 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"service/metadata"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Redacted Value logged in place of the redacted attributes.
+const Redacted = "[REDACTED]"
+
+// PayloadKeys Keys of the attributes carrying message payloads or author data, redacted unless
+// Config.Payloads is set.
+var PayloadKeys = []string{"payload", "body", "author", "sql"}
+
+// passwordPattern Matches the password of a key/value connection string.
+var passwordPattern = regexp.MustCompile(`(password\s*=\s*)('[^']*'|\S+)`)
+
+// Config Settings of the loggers created by New.
+type Config struct {
+	// Level Lowest level logged: debug, info, warn or error.
+	Level string
+	// Format Format of the records: text or json.
+	Format string
+	// Payloads Logs the attributes on PayloadKeys instead of redacting them.
+	Payloads bool
+}
+
+// DefaultConfig Returns the Config logging text records from the info level, redacting payloads.
+func DefaultConfig() Config {
+	return Config{Level: "info", Format: "text"}
+}
+
+// New Creates a logger writing to writer as set by config. Records logged with a context also get
+// its correlation id, actor and trace as attributes.
+func New(writer io.Writer, config Config) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(config.Level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q", config.Level)
+	}
+	options := &slog.HandlerOptions{Level: level}
+	if !config.Payloads {
+		options.ReplaceAttr = redactPayloads
+	}
+	var handler slog.Handler
+	switch config.Format {
+	case "text":
+		handler = slog.NewTextHandler(writer, options)
+	case "json":
+		handler = slog.NewJSONHandler(writer, options)
+	default:
+		return nil, fmt.Errorf("invalid log format %q", config.Format)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+// RedactAddress Returns the address of a broker or database with its password redacted, both for
+// URLs and key/value connection strings.
+func RedactAddress(address string) string {
+	if parsed, err := url.Parse(address); err == nil && parsed.User != nil {
+		return parsed.Redacted()
+	}
+	return passwordPattern.ReplaceAllString(address, "${1}"+Redacted)
+}
+
+// redactPayloads Replaces the value of the attributes on PayloadKeys with Redacted.
+func redactPayloads(_ []string, attr slog.Attr) slog.Attr {
+	for _, key := range PayloadKeys {
+		if attr.Key == key {
+			return slog.String(key, Redacted)
+		}
+	}
+	return attr
+}
+
+// contextHandler Handler adding the request metadata and trace carried by the context to every
+// record.
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle Adds the attributes of ctx to the record and handles it.
+func (handler contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if correlationID := metadata.CorrelationIDFrom(ctx); correlationID != "" {
+		record.AddAttrs(slog.String("correlation_id", correlationID))
+	}
+	if actor := metadata.ActorFrom(ctx); actor != "" {
+		record.AddAttrs(slog.String("actor", actor))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return handler.Handler.Handle(ctx, record)
+}
+
+// WithAttrs Returns a contextHandler whose records also get attrs.
+func (handler contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{handler.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup Returns a contextHandler whose attributes are nested in the group.
+func (handler contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{handler.Handler.WithGroup(name)}
+}
@@ -0,0 +1,65 @@
+package metadata
+
+import "context"
+
+const (
+	// ActorHeader Name of the header, on every transport, carrying the actor of a request.
+	ActorHeader = "x-actor"
+	// CorrelationIDHeader Name of the header, on gRPC and HTTP, carrying the correlation id of a
+	// request. AMQP messages use their correlation id property instead.
+	CorrelationIDHeader = "x-correlation-id"
+)
+
+// contextKey Type of the keys used to store the metadata on a context.
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	correlationIDKey
+	idempotencyKey
+)
+
+// WithActor Returns a copy of ctx carrying the actor, the user or service that sent the request.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFrom Returns the actor carried by ctx, or an empty string when it is unknown.
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey).(string)
+	return actor
+}
+
+// WithCorrelationID Returns a copy of ctx carrying the correlation id of the request, used to
+// relate the changes and logs it caused.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, correlationID)
+}
+
+// CorrelationIDFrom Returns the correlation id carried by ctx, or an empty string when it is
+// unknown.
+func CorrelationIDFrom(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDKey).(string)
+	return correlationID
+}
+
+// idempotency Identifies the message that requested a change.
+type idempotency struct {
+	key         string
+	fingerprint string
+}
+
+// WithIdempotencyKey Returns a copy of ctx carrying the key identifying the message that requested
+// the change on redeliveries, along with the fingerprint of its content, so the stores record it
+// processed along with the change. Messages reusing a key with a different content are told apart
+// by the fingerprint.
+func WithIdempotencyKey(ctx context.Context, key string, fingerprint string) context.Context {
+	return context.WithValue(ctx, idempotencyKey, idempotency{key: key, fingerprint: fingerprint})
+}
+
+// IdempotencyKeyFrom Returns the idempotency key and fingerprint carried by ctx, or empty strings
+// when the change wasn't requested by a message.
+func IdempotencyKeyFrom(ctx context.Context) (string, string) {
+	message, _ := ctx.Value(idempotencyKey).(idempotency)
+	return message.key, message.fingerprint
+}
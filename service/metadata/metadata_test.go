@@ -0,0 +1,33 @@
+package metadata
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestActor(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, ActorFrom(ctx))
+	assert.Equal(t, "billing-service", ActorFrom(WithActor(ctx, "billing-service")))
+}
+
+func TestCorrelationID(t *testing.T) {
+	ctx := WithActor(context.Background(), "billing-service")
+	assert.Empty(t, CorrelationIDFrom(ctx))
+	ctx = WithCorrelationID(ctx, "42")
+	assert.Equal(t, "42", CorrelationIDFrom(ctx))
+	assert.Equal(t, "billing-service", ActorFrom(ctx))
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := WithCorrelationID(context.Background(), "42")
+	key, fingerprint := IdempotencyKeyFrom(ctx)
+	assert.Empty(t, key)
+	assert.Empty(t, fingerprint)
+	ctx = WithIdempotencyKey(ctx, "message-1", "a1b2")
+	key, fingerprint = IdempotencyKeyFrom(ctx)
+	assert.Equal(t, "message-1", key)
+	assert.Equal(t, "a1b2", fingerprint)
+	assert.Equal(t, "42", CorrelationIDFrom(ctx))
+}
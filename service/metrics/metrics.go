@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"service/apperror"
+	"service/broker"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace Prefix of the name of every metric of the service.
+const namespace = "author_management"
+
+// OutcomeOK Outcome of the events processed without error. Failed events use the name of their
+// apperror.Code.
+const OutcomeOK = "OK"
+
+// Metrics Collects the metrics of the service and serves them on the /metrics endpoint.
+type Metrics struct {
+	registry      *prometheus.Registry
+	events        *prometheus.CounterVec
+	eventDuration *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+	queueLag      prometheus.Histogram
+}
+
+// New Creates a new Metrics with its own registry, also collecting the Go runtime and process
+// metrics.
+func New() *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "events_total",
+			Help:      "Events routed, by action and outcome.",
+		}, []string{"action", "outcome"}),
+		eventDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "event_duration_seconds",
+			Help:      "Time taken to route an event, by action and outcome.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"action", "outcome"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Time taken by the calls to the author store, by operation and outcome.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "outcome"}),
+		queueLag: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "queue_lag_seconds",
+			Help:      "Time between a message being published and its processing starting.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+		}),
+	}
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.events,
+		metrics.eventDuration,
+		metrics.queryDuration,
+		metrics.queueLag,
+	)
+	return metrics
+}
+
+// Register Adds the /metrics endpoint to the mux.
+func (metrics *Metrics) Register(mux *http.ServeMux) {
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{}))
+}
+
+// ObserveEvent Records an event routed with the passed action, taking duration and failing with
+// err when not nil.
+func (metrics *Metrics) ObserveEvent(action string, err error, duration time.Duration) {
+	outcome := OutcomeOK
+	if err != nil {
+		outcome = apperror.CodeOf(err).String()
+	}
+	metrics.events.WithLabelValues(action, outcome).Inc()
+	metrics.eventDuration.WithLabelValues(action, outcome).Observe(duration.Seconds())
+}
+
+// ObserveQuery Records a call to the operation of the author store, as AddAuthor, taking duration
+// and failing with err when not nil.
+func (metrics *Metrics) ObserveQuery(operation string, err error, duration time.Duration) {
+	outcome := OutcomeOK
+	if err != nil {
+		outcome = apperror.CodeOf(err).String()
+	}
+	metrics.queryDuration.WithLabelValues(operation, outcome).Observe(duration.Seconds())
+}
+
+// ObserveQueueLag Records the lag of a message published at the passed time. Messages published
+// without a timestamp are skipped.
+func (metrics *Metrics) ObserveQueueLag(published time.Time) {
+	if published.IsZero() {
+		return
+	}
+	metrics.queueLag.Observe(time.Since(published).Seconds())
+}
+
+// WatchDatabase Collects the stats of the connection pool of the database.
+func (metrics *Metrics) WatchDatabase(db *sql.DB) {
+	metrics.registry.MustRegister(collectors.NewDBStatsCollector(db, "authors"))
+}
+
+// WatchBroker Collects the state of the connection to the broker kept by the supervisor and the
+// times it reconnected.
+func (metrics *Metrics) WatchBroker(supervisor *broker.Supervisor) {
+	metrics.registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "broker_connected",
+			Help:      "Whether the connection to the broker is up.",
+		}, func() float64 {
+			if supervisor.State() == broker.Connected {
+				return 1
+			}
+			return 0
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "broker_reconnects_total",
+			Help:      "Times the connection to the broker was established again after being lost.",
+		}, func() float64 {
+			return float64(supervisor.Reconnects())
+		}),
+	)
+}
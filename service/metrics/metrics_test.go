@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"service/apperror"
+	"service/broker"
+	"service/database"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// scrape Returns the metrics served on the /metrics endpoint.
+func scrape(t *testing.T, metrics *Metrics) string {
+	mux := http.NewServeMux()
+	metrics.Register(mux)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body, err := io.ReadAll(recorder.Body)
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics_ObserveEvent(t *testing.T) {
+	metrics := New()
+	metrics.ObserveEvent("CREATE", nil, 10*time.Millisecond)
+	metrics.ObserveEvent("CREATE", nil, 20*time.Millisecond)
+	metrics.ObserveEvent("READ", apperror.New(apperror.NotFound, "author not found"), time.Millisecond)
+	metrics.ObserveEvent("DELETE", errors.New("failed"), time.Millisecond)
+
+	body := scrape(t, metrics)
+	assert.Contains(t, body, `author_management_events_total{action="CREATE",outcome="OK"} 2`)
+	assert.Contains(t, body, `author_management_events_total{action="READ",outcome="NOT_FOUND"} 1`)
+	assert.Contains(t, body, `author_management_events_total{action="DELETE",outcome="INTERNAL"} 1`)
+	assert.Contains(t, body, `author_management_event_duration_seconds_count{action="CREATE",outcome="OK"} 2`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestMetrics_ObserveQueueLag(t *testing.T) {
+	metrics := New()
+	metrics.ObserveQueueLag(time.Time{})
+	metrics.ObserveQueueLag(time.Now().Add(-time.Second))
+
+	assert.Contains(t, scrape(t, metrics), "author_management_queue_lag_seconds_count 1")
+}
+
+func TestMetrics_WatchBroker(t *testing.T) {
+	metrics := New()
+	metrics.WatchBroker(broker.NewSupervisor("amqp://localhost", broker.DefaultBackoff(), slog.Default()))
+
+	body := scrape(t, metrics)
+	assert.Contains(t, body, "author_management_broker_connected 0")
+	assert.Contains(t, body, "author_management_broker_reconnects_total 0")
+}
+
+func TestMetrics_WatchDatabase(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, sqlDB.Close()) })
+
+	metrics := New()
+	metrics.WatchDatabase(sqlDB)
+	assert.Contains(t, scrape(t, metrics), `go_sql_open_connections{db_name="authors"}`)
+}
+
+func TestStore(t *testing.T) {
+	metrics := New()
+	store := NewStore(database.NewMemoryStore(), metrics)
+	ctx := context.Background()
+	id, err := store.AddAuthor(ctx, database.Author{Name: "Dune"})
+	assert.NoError(t, err)
+	_, err = store.GetAuthor(ctx, id.String())
+	assert.NoError(t, err)
+	_, err = store.GetAuthor(ctx, "00000000-0000-0000-0000-000000000000")
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+
+	body := scrape(t, metrics)
+	assert.Contains(t, body, `author_management_db_query_duration_seconds_count{operation="AddAuthor",outcome="OK"} 1`)
+	assert.Contains(t, body, `author_management_db_query_duration_seconds_count{operation="GetAuthor",outcome="OK"} 1`)
+	assert.Contains(t, body,
+		`author_management_db_query_duration_seconds_count{operation="GetAuthor",outcome="NOT_FOUND"} 1`)
+}
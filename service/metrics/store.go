@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+	"service/database"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Store AuthorStore recording the duration of every call to the wrapped one on the Metrics, by
+// method and outcome.
+type Store struct {
+	store   database.AuthorStore
+	metrics *Metrics
+}
+
+var _ database.AuthorStore = (*Store)(nil)
+
+// NewStore Creates a new Store timing the calls to store on metrics.
+func NewStore(store database.AuthorStore, metrics *Metrics) *Store {
+	return &Store{store: store, metrics: metrics}
+}
+
+// AddAuthor Adds an author to the wrapped store.
+func (store *Store) AddAuthor(ctx context.Context, author database.Author) (*uuid.UUID, error) {
+	started := time.Now()
+	id, err := store.store.AddAuthor(ctx, author)
+	store.metrics.ObserveQuery("AddAuthor", err, time.Since(started))
+	return id, err
+}
+
+// GetAuthor Queries an author on the wrapped store.
+func (store *Store) GetAuthor(ctx context.Context, uuid string) (*database.Author, error) {
+	started := time.Now()
+	author, err := store.store.GetAuthor(ctx, uuid)
+	store.metrics.ObserveQuery("GetAuthor", err, time.Since(started))
+	return author, err
+}
+
+// ListAuthors Gets one page of authors from the wrapped store.
+func (store *Store) ListAuthors(ctx context.Context, options database.ListOptions) (database.AuthorPage, error) {
+	started := time.Now()
+	page, err := store.store.ListAuthors(ctx, options)
+	store.metrics.ObserveQuery("ListAuthors", err, time.Since(started))
+	return page, err
+}
+
+// UpdateAuthor Updates an author on the wrapped store.
+func (store *Store) UpdateAuthor(ctx context.Context, author database.Author, options database.UpdateOptions) error {
+	started := time.Now()
+	err := store.store.UpdateAuthor(ctx, author, options)
+	store.metrics.ObserveQuery("UpdateAuthor", err, time.Since(started))
+	return err
+}
+
+// DeleteAuthor Soft deletes an author on the wrapped store.
+func (store *Store) DeleteAuthor(ctx context.Context, uuid string, options database.DeleteOptions) error {
+	started := time.Now()
+	err := store.store.DeleteAuthor(ctx, uuid, options)
+	store.metrics.ObserveQuery("DeleteAuthor", err, time.Since(started))
+	return err
+}
+
+// RestoreAuthor Restores an author on the wrapped store.
+func (store *Store) RestoreAuthor(ctx context.Context, uuid string) error {
+	started := time.Now()
+	err := store.store.RestoreAuthor(ctx, uuid)
+	store.metrics.ObserveQuery("RestoreAuthor", err, time.Since(started))
+	return err
+}
+
+// PurgeAuthors Purges the deleted authors of the wrapped store.
+func (store *Store) PurgeAuthors(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	started := time.Now()
+	purged, err := store.store.PurgeAuthors(ctx, deletedBefore)
+	store.metrics.ObserveQuery("PurgeAuthors", err, time.Since(started))
+	return purged, err
+}
+
+// GetAuthorHistory Queries the audit log of an author on the wrapped store.
+func (store *Store) GetAuthorHistory(ctx context.Context, uuid string) ([]database.AuditEntry, error) {
+	started := time.Now()
+	history, err := store.store.GetAuthorHistory(ctx, uuid)
+	store.metrics.ObserveQuery("GetAuthorHistory", err, time.Since(started))
+	return history, err
+}
@@ -0,0 +1,61 @@
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"service/database"
+	"time"
+)
+
+const (
+	// DefaultRetention Time soft deleted authors are kept before being purged by default.
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultInterval Time between purges by default.
+	DefaultInterval = time.Hour
+)
+
+// Job Scheduled job permanently deleting the authors that were soft deleted for longer than the
+// retention period.
+type Job struct {
+	store     database.AuthorStore
+	retention time.Duration
+	interval  time.Duration
+	logger    *slog.Logger
+}
+
+// NewJob Creates a new Job purging the authors of the store deleted for longer than retention,
+// once every interval, and logging each purge to logger.
+func NewJob(store database.AuthorStore, retention time.Duration, interval time.Duration, logger *slog.Logger) *Job {
+	return &Job{
+		store:     store,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// RunOnce Purges the authors deleted for longer than the retention period, returning how many
+// were purged.
+func (job *Job) RunOnce(ctx context.Context) (int64, error) {
+	return job.store.PurgeAuthors(ctx, time.Now().Add(-job.retention))
+}
+
+// Run Purges the authors right away and then once every interval, until ctx is done. Failed
+// purges are logged and retried on the next run.
+func (job *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+	for {
+		purged, err := job.RunOnce(ctx)
+		if err != nil {
+			job.logger.ErrorContext(ctx, "Failed to purge deleted authors", "error", err)
+		} else if purged > 0 {
+			job.logger.InfoContext(ctx, "Purged deleted authors", "count", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
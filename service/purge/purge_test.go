@@ -0,0 +1,57 @@
+package purge
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"service/database"
+	"testing"
+	"time"
+)
+
+// addDeletedAuthor Adds an author to the store and soft deletes it.
+func addDeletedAuthor(t *testing.T, store database.AuthorStore) string {
+	ctx := context.Background()
+	id, err := store.AddAuthor(ctx, database.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.DeleteAuthor(ctx, id.String(), database.DeleteOptions{}))
+	return id.String()
+}
+
+func TestJob_RunOnce(t *testing.T) {
+	store := database.NewMemoryStore()
+	addDeletedAuthor(t, store)
+
+	purged, err := NewJob(store, time.Hour, time.Hour, slog.Default()).RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, purged)
+
+	purged, err = NewJob(store, -time.Second, time.Hour, slog.Default()).RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+}
+
+func TestJob_RunUntilCancelled(t *testing.T) {
+	store := database.NewMemoryStore()
+	id := addDeletedAuthor(t, store)
+	ctx, cancel := context.WithCancel(context.Background())
+	output := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(output, nil))
+	done := make(chan struct{})
+	go func() {
+		NewJob(store, -time.Second, time.Millisecond, logger).Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return store.RestoreAuthor(context.Background(), id) != nil
+	}, time.Second, time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job didn't stop after the context was cancelled")
+	}
+	assert.Contains(t, output.String(), "Purged deleted authors", "the purge is logged to the passed logger")
+}
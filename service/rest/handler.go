@@ -0,0 +1,402 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"service/apperror"
+	"service/database"
+	"service/metadata"
+	"service/router"
+	"strconv"
+	"strings"
+
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// authorsPath Path of the authors collection, single authors live under it.
+const authorsPath = "/authors"
+
+// restorePath Suffix of the path restoring a soft deleted author.
+const restorePath = "/restore"
+
+// historyPath Suffix of the path reading the changes made to an author.
+const historyPath = "/history"
+
+// maxBodySize Maximum size accepted on request bodies.
+const maxBodySize = 1 << 20
+
+// replacedFields Fields overwritten by a PUT, which replaces the whole author.
+var replacedFields = []string{string(database.FieldName), string(database.FieldPicURL)}
+
+// Handler HTTP handler exposing the authors through a JSON API. It shares the RouteManager
+// used by the message broker consumer so both transports behave the same way.
+type Handler struct {
+	routeManager *router.RouteManager
+}
+
+// NewHandler Creates a new Handler backed by the passed RouteManager.
+func NewHandler(routeManager *router.RouteManager) *Handler {
+	return &Handler{
+		routeManager: routeManager,
+	}
+}
+
+// Register Registers the authors routes on the passed mux.
+func (handler *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc(authorsPath, withMetadata(handler.handleCollection))
+	mux.HandleFunc(authorsPath+"/", withMetadata(handler.handleAuthor))
+}
+
+// withMetadata Adds the actor and correlation id sent on the X-Actor and X-Correlation-Id
+// headers to the context of the request.
+func withMetadata(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		ctx := request.Context()
+		if actor := request.Header.Get(metadata.ActorHeader); actor != "" {
+			ctx = metadata.WithActor(ctx, actor)
+		}
+		if correlationID := request.Header.Get(metadata.CorrelationIDHeader); correlationID != "" {
+			ctx = metadata.WithCorrelationID(ctx, correlationID)
+		}
+		next(writer, request.WithContext(ctx))
+	}
+}
+
+// handleCollection Handles requests to the authors collection.
+func (handler *Handler) handleCollection(writer http.ResponseWriter, request *http.Request) {
+	switch request.Method {
+	case http.MethodGet:
+		handler.listAuthors(writer, request)
+	case http.MethodPost:
+		handler.createAuthor(writer, request)
+	default:
+		writeMethodNotAllowed(writer, http.MethodGet, http.MethodPost)
+	}
+}
+
+// handleAuthor Handles requests to a single author identified by the uuid on the path.
+func (handler *Handler) handleAuthor(writer http.ResponseWriter, request *http.Request) {
+	uuid := strings.TrimPrefix(request.URL.Path, authorsPath+"/")
+	if restored := strings.TrimSuffix(uuid, restorePath); restored != uuid {
+		handler.handleRestore(writer, request, restored)
+		return
+	}
+	if audited := strings.TrimSuffix(uuid, historyPath); audited != uuid {
+		handler.handleHistory(writer, request, audited)
+		return
+	}
+	if uuid == "" || strings.Contains(uuid, "/") {
+		writeError(writer, apperror.New(apperror.NotFound, "path not found"))
+		return
+	}
+	switch request.Method {
+	case http.MethodGet:
+		handler.getAuthor(writer, request, uuid)
+	case http.MethodPut:
+		handler.replaceAuthor(writer, request, uuid)
+	case http.MethodPatch:
+		handler.updateAuthor(writer, request, uuid)
+	case http.MethodDelete:
+		handler.deleteAuthor(writer, request, uuid)
+	default:
+		writeMethodNotAllowed(writer, http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	}
+}
+
+// handleRestore Handles requests restoring a soft deleted author.
+func (handler *Handler) handleRestore(writer http.ResponseWriter, request *http.Request, uuid string) {
+	if uuid == "" || strings.Contains(uuid, "/") {
+		writeError(writer, apperror.New(apperror.NotFound, "path not found"))
+		return
+	}
+	if request.Method != http.MethodPost {
+		writeMethodNotAllowed(writer, http.MethodPost)
+		return
+	}
+	if err := handler.routeManager.RestoreAuthor(request.Context(), uuid); err != nil {
+		writeError(writer, err)
+		return
+	}
+	handler.getAuthor(writer, request, uuid)
+}
+
+// handleHistory Handles requests reading the changes made to an author.
+func (handler *Handler) handleHistory(writer http.ResponseWriter, request *http.Request, uuid string) {
+	if uuid == "" || strings.Contains(uuid, "/") {
+		writeError(writer, apperror.New(apperror.NotFound, "path not found"))
+		return
+	}
+	if request.Method != http.MethodGet {
+		writeMethodNotAllowed(writer, http.MethodGet)
+		return
+	}
+	history, err := handler.routeManager.GetAuthorHistory(request.Context(), uuid)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	writeMessage(writer, http.StatusOK, history)
+}
+
+// createAuthor Creates the author sent on the body and replies with its stored state.
+func (handler *Handler) createAuthor(writer http.ResponseWriter, request *http.Request) {
+	author := &authorManagementProto.Author{}
+	if err := readBody(writer, request, author); err != nil {
+		writeError(writer, err)
+		return
+	}
+	uuid, err := handler.routeManager.CreateAuthor(request.Context(), author)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	created, err := handler.routeManager.GetAuthor(request.Context(), uuid)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	writer.Header().Set("Location", authorsPath+"/"+uuid)
+	writeAuthor(writer, http.StatusCreated, created)
+}
+
+// getAuthor Replies with the author registered with the uuid.
+func (handler *Handler) getAuthor(writer http.ResponseWriter, request *http.Request, uuid string) {
+	author, err := handler.routeManager.GetAuthor(request.Context(), uuid)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	writeAuthor(writer, http.StatusOK, author)
+}
+
+// listAuthors Replies with a page of authors using the pagination and filters set on the
+// query string.
+func (handler *Handler) listAuthors(writer http.ResponseWriter, request *http.Request) {
+	query, err := queryFromRequest(request)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	authors, err := handler.routeManager.ListAuthors(request.Context(), query)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	writeMessage(writer, http.StatusOK, authors)
+}
+
+// replaceAuthor Replaces the author registered with the uuid with the one sent on the body, clearing
+// the fields left empty, and replies with its new state.
+func (handler *Handler) replaceAuthor(writer http.ResponseWriter, request *http.Request, uuid string) {
+	if request.URL.Query().Has("updateMask") {
+		writeError(writer, apperror.New(apperror.InvalidArgument, "updateMask is only supported on PATCH"))
+		return
+	}
+	handler.writeAuthorChanges(writer, request, uuid, &fieldmaskpb.FieldMask{Paths: replacedFields})
+}
+
+// updateAuthor Updates the author registered with the uuid and replies with its new state. The
+// fields to overwrite can be set, comma separated, on the updateMask query parameter, otherwise
+// the non empty fields of the body are overwritten.
+func (handler *Handler) updateAuthor(writer http.ResponseWriter, request *http.Request, uuid string) {
+	var updateMask *fieldmaskpb.FieldMask
+	if paths := request.URL.Query().Get("updateMask"); paths != "" {
+		updateMask = &fieldmaskpb.FieldMask{Paths: strings.Split(paths, ",")}
+	}
+	handler.writeAuthorChanges(writer, request, uuid, updateMask)
+}
+
+// writeAuthorChanges Writes the fields of updateMask from the author on the body into the one
+// registered with the uuid, and replies with its new state. The expected version is taken from
+// the If-Match header or the body.
+func (handler *Handler) writeAuthorChanges(writer http.ResponseWriter, request *http.Request, uuid string,
+	updateMask *fieldmaskpb.FieldMask) {
+	author := &authorManagementProto.Author{}
+	if err := readBody(writer, request, author); err != nil {
+		writeError(writer, err)
+		return
+	}
+	if author.Uuid != nil && author.GetUuid() != uuid {
+		writeError(writer, apperror.New(apperror.InvalidArgument, "uuid on the body doesn't match the path"))
+		return
+	}
+	author.Uuid = &uuid
+	version, err := expectedVersion(request)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	if version != 0 {
+		author.Version = version
+	}
+	if err := handler.routeManager.UpdateAuthor(request.Context(), author, updateMask); err != nil {
+		writeError(writer, err)
+		return
+	}
+	handler.getAuthor(writer, request, uuid)
+}
+
+// deleteAuthor Deletes the author registered with the uuid, checking the version on the
+// If-Match header when set.
+func (handler *Handler) deleteAuthor(writer http.ResponseWriter, request *http.Request, uuid string) {
+	version, err := expectedVersion(request)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	if err := handler.routeManager.DeleteAuthor(request.Context(), uuid, version); err != nil {
+		writeError(writer, err)
+		return
+	}
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// queryFromRequest Builds the AuthorQuery used on listings from the request query string.
+func queryFromRequest(request *http.Request) (*authorManagementProto.AuthorQuery, error) {
+	values := request.URL.Query()
+	query := &authorManagementProto.AuthorQuery{
+		AllEntries:   true,
+		PageToken:    values.Get("pageToken"),
+		NamePrefix:   values.Get("namePrefix"),
+		NameContains: values.Get("nameContains"),
+	}
+	if pageSize := values.Get("pageSize"); pageSize != "" {
+		parsed, err := strconv.ParseUint(pageSize, 10, 32)
+		if err != nil {
+			return nil, apperror.New(apperror.InvalidArgument, "pageSize must be a positive number")
+		}
+		query.PageSize = uint32(parsed)
+	}
+	if includeDeleted := values.Get("includeDeleted"); includeDeleted != "" {
+		parsed, err := strconv.ParseBool(includeDeleted)
+		if err != nil {
+			return nil, apperror.New(apperror.InvalidArgument, "includeDeleted must be true or false")
+		}
+		query.IncludeDeleted = parsed
+	}
+	switch values.Get("orderBy") {
+	case "", "id":
+		query.OrderBy = authorManagementProto.AuthorQuery_ORDER_BY_ID
+	case "name":
+		query.OrderBy = authorManagementProto.AuthorQuery_ORDER_BY_NAME
+	case "createdAt":
+		query.OrderBy = authorManagementProto.AuthorQuery_ORDER_BY_CREATED_AT
+	case "updatedAt":
+		query.OrderBy = authorManagementProto.AuthorQuery_ORDER_BY_UPDATED_AT
+	default:
+		return nil, apperror.New(apperror.InvalidArgument, "orderBy must be id, name, createdAt or updatedAt")
+	}
+	return query, nil
+}
+
+// httpStatuses Maps the service error codes into HTTP status codes.
+var httpStatuses = map[apperror.Code]int{
+	apperror.Internal:        http.StatusInternalServerError,
+	apperror.NotFound:        http.StatusNotFound,
+	apperror.AlreadyExists:   http.StatusConflict,
+	apperror.InvalidArgument: http.StatusBadRequest,
+	apperror.Unavailable:     http.StatusServiceUnavailable,
+	apperror.Conflict:        http.StatusConflict,
+}
+
+// readBody Reads the JSON body of the request into the passed message. Bodies larger than
+// maxBodySize fail with an error wrapping http.MaxBytesError.
+func readBody(writer http.ResponseWriter, request *http.Request, message proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return apperror.Wrap(apperror.InvalidArgument, "body larger than 1MiB", err)
+		}
+		return apperror.Wrap(apperror.InvalidArgument, "failed to read the body", err)
+	}
+	if err := protojson.Unmarshal(body, message); err != nil {
+		return apperror.Wrap(apperror.InvalidArgument, "invalid JSON body", err)
+	}
+	return nil
+}
+
+// expectedVersion Reads the version sent on the If-Match header, which holds the ETag of a
+// previous response. Returns zero when the header is not set.
+func expectedVersion(request *http.Request) (uint64, error) {
+	etag := request.Header.Get("If-Match")
+	if etag == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version == 0 {
+		return 0, apperror.New(apperror.InvalidArgument, "invalid If-Match header")
+	}
+	return version, nil
+}
+
+// writeAuthor Writes the author as JSON with the passed status, sending its version as the ETag.
+func writeAuthor(writer http.ResponseWriter, status int, author *authorManagementProto.Author) {
+	writer.Header().Set("ETag", strconv.Quote(strconv.FormatUint(author.Version, 10)))
+	writeMessage(writer, status, author)
+}
+
+// writeMessage Writes the proto message as JSON with the passed status.
+func writeMessage(writer http.ResponseWriter, status int, message proto.Message) {
+	body, err := protojson.Marshal(message)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	writer.Write(body)
+}
+
+// violationBody JSON description of a field that is not valid.
+type violationBody struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// errorBody JSON body sent when a request fails.
+type errorBody struct {
+	Code       string          `json:"code"`
+	Message    string          `json:"message"`
+	Violations []violationBody `json:"violations,omitempty"`
+}
+
+// writeError Writes the error code, message and field violations as JSON, using the status
+// mapped from the code. Bodies that are too large are replied with 413.
+func writeError(writer http.ResponseWriter, err error) {
+	code := apperror.CodeOf(err)
+	body := errorBody{
+		Code:    code.String(),
+		Message: apperror.MessageOf(err),
+	}
+	for _, violation := range apperror.ViolationsOf(err) {
+		body.Violations = append(body.Violations, violationBody(violation))
+	}
+	status := httpStatuses[code]
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	writeErrorBody(writer, status, body)
+}
+
+// writeErrorBody Writes the error body as JSON with the passed status.
+func writeErrorBody(writer http.ResponseWriter, status int, body errorBody) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(body)
+}
+
+// writeMethodNotAllowed Replies that the method is not supported, listing the allowed ones.
+func writeMethodNotAllowed(writer http.ResponseWriter, allowed ...string) {
+	writer.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeErrorBody(writer, http.StatusMethodNotAllowed, errorBody{
+		Code:    apperror.InvalidArgument.String(),
+		Message: "method not allowed",
+	})
+}
@@ -0,0 +1,301 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"net/http/httptest"
+	"service/apperror"
+	"service/database"
+	"service/router"
+	"strings"
+	"testing"
+)
+
+// newTestServer Starts an HTTP server with the authors routes backed by a MemoryStore.
+func newTestServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	NewHandler(router.NewRouteManager(database.NewMemoryStore())).Register(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// doRequest Sends a request to the test server and returns the response.
+func doRequest(t *testing.T, method string, url string, body string) *http.Response {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	t.Cleanup(func() { response.Body.Close() })
+	return response
+}
+
+// decodeAuthor Decodes the author sent on the response body.
+func decodeAuthor(t *testing.T, response *http.Response) *authorManagementProto.Author {
+	var raw json.RawMessage
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&raw))
+	author := &authorManagementProto.Author{}
+	assert.NoError(t, protojson.Unmarshal(raw, author))
+	return author
+}
+
+func TestHandler_CreateAndGetAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe", "picUrl": "https://example.com/johndoe.png"}`)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	created := decodeAuthor(t, response)
+	assert.NotEmpty(t, created.GetUuid())
+	assert.Equal(t, "/authors/"+created.GetUuid(), response.Header.Get("Location"))
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors/"+created.GetUuid(), "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	author := decodeAuthor(t, response)
+	assert.Equal(t, "John Doe", author.Name)
+	assert.Equal(t, "https://example.com/johndoe.png", author.GetPicUrl())
+}
+
+func TestHandler_CreateDuplicatedAuthor(t *testing.T) {
+	server := newTestServer(t)
+	body := `{"uuid": "` + uuid.NewString() + `", "name": "John Doe"}`
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", body)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	response = doRequest(t, http.MethodPost, server.URL+"/authors", body)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+}
+
+func TestHandler_CreateWithInvalidBody(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": 1}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestHandler_GetNonExistentAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodGet, server.URL+"/authors/"+uuid.NewString(), "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	var body errorBody
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.Equal(t, "NOT_FOUND", body.Code)
+	assert.Equal(t, database.ErrAuthorNotFound.Message, body.Message)
+}
+
+func TestHandler_ListAuthors(t *testing.T) {
+	server := newTestServer(t)
+	for _, name := range []string{"John Doe", "Jane Doe", "Mary Major"} {
+		response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "`+name+`"}`)
+		assert.Equal(t, http.StatusCreated, response.StatusCode)
+	}
+	response := doRequest(t, http.MethodGet, server.URL+"/authors?pageSize=1&orderBy=name&nameContains=doe", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var raw json.RawMessage
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&raw))
+	authors := &authorManagementProto.AuthorList{}
+	assert.NoError(t, protojson.Unmarshal(raw, authors))
+	assert.Len(t, authors.Authors, 1)
+	assert.Equal(t, "Jane Doe", authors.Authors[0].Name)
+	assert.NotEmpty(t, authors.NextPageToken)
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors?orderBy=age", "")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response = doRequest(t, http.MethodGet, server.URL+"/authors?pageToken=invalid", "")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestHandler_UpdateAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe"}`)
+	created := decodeAuthor(t, response)
+
+	response = doRequest(t, http.MethodPatch, server.URL+"/authors/"+created.GetUuid(), `{"picUrl": "https://example.com/new.png"}`)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	updated := decodeAuthor(t, response)
+	assert.Equal(t, "John Doe", updated.Name)
+	assert.Equal(t, "https://example.com/new.png", updated.GetPicUrl())
+
+	response = doRequest(t, http.MethodPatch, server.URL+"/authors/"+created.GetUuid(), `{"uuid": "`+uuid.NewString()+`"}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response = doRequest(t, http.MethodPatch, server.URL+"/authors/"+uuid.NewString(), `{"name": "Jane Doe"}`)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHandler_ReplaceAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe", "picUrl": "https://example.com/johndoe.png"}`)
+	created := decodeAuthor(t, response)
+
+	response = doRequest(t, http.MethodPut, server.URL+"/authors/"+created.GetUuid(), `{"name": "Jane Doe"}`)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	replaced := decodeAuthor(t, response)
+	assert.Equal(t, "Jane Doe", replaced.Name)
+	assert.Nil(t, replaced.PicUrl)
+
+	response = doRequest(t, http.MethodPut, server.URL+"/authors/"+created.GetUuid(), `{"picUrl": "https://example.com/new.png"}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response = doRequest(t, http.MethodPut, server.URL+"/authors/"+created.GetUuid()+"?updateMask=name", `{"name": "Jane Doe"}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response = doRequest(t, http.MethodPut, server.URL+"/authors/"+uuid.NewString(), `{"name": "Jane Doe"}`)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHandler_BodyTooLarge(t *testing.T) {
+	server := newTestServer(t)
+	body := `{"name": "` + strings.Repeat("a", maxBodySize) + `"}`
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", body)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.StatusCode)
+}
+
+func TestHandler_UpdateAuthorWithMask(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe", "picUrl": "https://example.com/johndoe.png"}`)
+	created := decodeAuthor(t, response)
+
+	response = doRequest(t, http.MethodPatch, server.URL+"/authors/"+created.GetUuid()+"?updateMask=name,picUrl", `{"name": "Jane Doe"}`)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	updated := decodeAuthor(t, response)
+	assert.Equal(t, "Jane Doe", updated.Name)
+	assert.Nil(t, updated.PicUrl)
+
+	response = doRequest(t, http.MethodPatch, server.URL+"/authors/"+created.GetUuid()+"?updateMask=uuid", `{}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestHandler_UpdateAuthorWithIfMatch(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe"}`)
+	created := decodeAuthor(t, response)
+	assert.Equal(t, `"1"`, response.Header.Get("ETag"))
+
+	request, err := http.NewRequest(http.MethodPatch, server.URL+"/authors/"+created.GetUuid(), strings.NewReader(`{"name": "Jane Doe"}`))
+	assert.NoError(t, err)
+	request.Header.Set("If-Match", `"1"`)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, `"2"`, response.Header.Get("ETag"))
+
+	request, err = http.NewRequest(http.MethodDelete, server.URL+"/authors/"+created.GetUuid(), nil)
+	assert.NoError(t, err)
+	request.Header.Set("If-Match", `"1"`)
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	request.Header.Set("If-Match", "invalid")
+	response, err = http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestHandler_RecordsActor(t *testing.T) {
+	server := newTestServer(t)
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/authors", strings.NewReader(`{"name": "John Doe"}`))
+	assert.NoError(t, err)
+	request.Header.Set("X-Actor", "billing-service")
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	created := decodeAuthor(t, response)
+	assert.Equal(t, "billing-service", created.CreatedBy)
+	assert.NotNil(t, created.CreatedAt)
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors?orderBy=updatedAt", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestHandler_DeleteAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe"}`)
+	created := decodeAuthor(t, response)
+
+	response = doRequest(t, http.MethodDelete, server.URL+"/authors/"+created.GetUuid(), "")
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	response = doRequest(t, http.MethodDelete, server.URL+"/authors/"+created.GetUuid(), "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHandler_RestoreAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": "John Doe"}`)
+	created := decodeAuthor(t, response)
+	response = doRequest(t, http.MethodDelete, server.URL+"/authors/"+created.GetUuid(), "")
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors?includeDeleted=true", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var raw json.RawMessage
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&raw))
+	authors := &authorManagementProto.AuthorList{}
+	assert.NoError(t, protojson.Unmarshal(raw, authors))
+	assert.Len(t, authors.Authors, 1)
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors/"+created.GetUuid()+"/restore", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	response = doRequest(t, http.MethodPost, server.URL+"/authors/"+created.GetUuid()+"/restore", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "John Doe", decodeAuthor(t, response).Name)
+	response = doRequest(t, http.MethodPost, server.URL+"/authors/"+uuid.NewString()+"/restore", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHandler_GetAuthorHistory(t *testing.T) {
+	server := newTestServer(t)
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/authors", strings.NewReader(`{"name": "John Doe"}`))
+	assert.NoError(t, err)
+	request.Header.Set("X-Correlation-Id", "42")
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	created := decodeAuthor(t, response)
+
+	response = doRequest(t, http.MethodGet, server.URL+"/authors/"+created.GetUuid()+"/history", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	var raw json.RawMessage
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&raw))
+	history := &authorManagementProto.AuthorHistory{}
+	assert.NoError(t, protojson.Unmarshal(raw, history))
+	assert.Len(t, history.Entries, 1)
+	assert.Equal(t, "42", history.Entries[0].CorrelationId)
+	assert.Equal(t, "John Doe", history.Entries[0].After.Name)
+
+	response = doRequest(t, http.MethodPost, server.URL+"/authors/"+created.GetUuid()+"/history", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	response = doRequest(t, http.MethodGet, server.URL+"/authors/"+uuid.NewString()+"/history", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestHandler_MethodNotAllowed(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodDelete, server.URL+"/authors", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	assert.Equal(t, "GET, POST", response.Header.Get("Allow"))
+}
+
+func TestWriteErrorUnavailable(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writeError(recorder, apperror.Wrap(apperror.Unavailable, "database unavailable", errors.New("connection refused")))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	var body errorBody
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	assert.Equal(t, errorBody{Code: "UNAVAILABLE", Message: "database unavailable"}, body)
+}
+
+func TestHandler_CreateInvalidAuthor(t *testing.T) {
+	server := newTestServer(t)
+	response := doRequest(t, http.MethodPost, server.URL+"/authors", `{"name": " ", "picUrl": "ftp://example.com"}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	var body errorBody
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.Equal(t, "INVALID_ARGUMENT", body.Code)
+	assert.Equal(t, []violationBody{
+		{Field: "name", Description: "name is required"},
+		{Field: "picUrl", Description: "picUrl must use http or https"},
+	}, body.Violations)
+}
@@ -0,0 +1,108 @@
+package router
+
+import (
+	"context"
+	"service/apperror"
+	"service/database"
+	"service/metadata"
+
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ErrMissingUUID Returned when an operation that targets a single author receives no uuid.
+var ErrMissingUUID = apperror.New(apperror.InvalidArgument, "uuid not set on the request")
+
+// CreateAuthor Creates an author and returns the uuid it was stored with. The actor carried by
+// ctx is recorded as the one that created it.
+func (rm *RouteManager) CreateAuthor(ctx context.Context, author *authorManagementProto.Author) (string, error) {
+	parsedAuthor, err := database.AuthorFromGrpc(author)
+	if err != nil {
+		return "", err
+	}
+	if err := rm.validator.Validate(&parsedAuthor, true); err != nil {
+		return "", err
+	}
+	parsedAuthor.CreatedBy = metadata.ActorFrom(ctx)
+	parsedAuthor.UpdatedBy = parsedAuthor.CreatedBy
+	uuid, err := rm.connector.AddAuthor(ctx, parsedAuthor)
+	if err != nil {
+		return "", err
+	}
+	return uuid.String(), nil
+}
+
+// GetAuthor Reads the author registered with the passed uuid.
+func (rm *RouteManager) GetAuthor(ctx context.Context, uuid string) (*authorManagementProto.Author, error) {
+	if uuid == "" {
+		return nil, ErrMissingUUID
+	}
+	author, err := rm.connector.GetAuthor(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return database.AuthorToGrpc(*author), nil
+}
+
+// ListAuthors Reads a page of authors using the pagination and filters of the query.
+func (rm *RouteManager) ListAuthors(ctx context.Context, query *authorManagementProto.AuthorQuery) (*authorManagementProto.AuthorList, error) {
+	page, err := rm.connector.ListAuthors(ctx, database.ListOptionsFromGrpc(query))
+	if err != nil {
+		return nil, err
+	}
+	parsedAuthors := database.AuthorPageToGrpcList(page)
+	return &parsedAuthors, nil
+}
+
+// UpdateAuthor Updates an author with the new data passed. Only the fields on the updateMask are
+// overwritten, clearing the ones left empty. Without a mask every non empty field is overwritten.
+// When the author carries a version the update fails unless the stored author still has it. The
+// actor carried by ctx is recorded as the one that last updated it.
+func (rm *RouteManager) UpdateAuthor(ctx context.Context, author *authorManagementProto.Author, updateMask *fieldmaskpb.FieldMask) error {
+	parsedAuthor, err := database.AuthorFromGrpc(author)
+	if err != nil {
+		return err
+	}
+	options, err := database.UpdateOptionsFromGrpc(updateMask)
+	if err != nil {
+		return err
+	}
+	options.ExpectedVersion = parsedAuthor.Version
+	if err := rm.validator.Validate(&parsedAuthor, options.Has(database.FieldName)); err != nil {
+		return err
+	}
+	if options.Has(database.FieldPicURL) && parsedAuthor.PicURL != nil && *parsedAuthor.PicURL == "" {
+		parsedAuthor.PicURL = nil
+	}
+	parsedAuthor.UpdatedBy = metadata.ActorFrom(ctx)
+	return rm.connector.UpdateAuthor(ctx, parsedAuthor, options)
+}
+
+// DeleteAuthor Soft deletes the author registered with the passed uuid. A non zero version makes
+// the delete fail unless the stored author still has it.
+func (rm *RouteManager) DeleteAuthor(ctx context.Context, uuid string, version uint64) error {
+	if uuid == "" {
+		return ErrMissingUUID
+	}
+	return rm.connector.DeleteAuthor(ctx, uuid, database.DeleteOptions{ExpectedVersion: version})
+}
+
+// RestoreAuthor Restores the soft deleted author registered with the passed uuid.
+func (rm *RouteManager) RestoreAuthor(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return ErrMissingUUID
+	}
+	return rm.connector.RestoreAuthor(ctx, uuid)
+}
+
+// GetAuthorHistory Reads the changes made to the author registered with the passed uuid.
+func (rm *RouteManager) GetAuthorHistory(ctx context.Context, uuid string) (*authorManagementProto.AuthorHistory, error) {
+	if uuid == "" {
+		return nil, ErrMissingUUID
+	}
+	entries, err := rm.connector.GetAuthorHistory(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return database.AuthorHistoryToGrpc(entries), nil
+}
@@ -0,0 +1,63 @@
+package router
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"service/database"
+	"service/metadata"
+	"testing"
+)
+
+func TestRouteManager_CreateAndGetAuthor(t *testing.T) {
+	ctx := context.Background()
+	router := NewRouteManager(database.NewMemoryStore())
+	uuid, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	author, err := router.GetAuthor(ctx, uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid, author.GetUuid())
+	assert.Equal(t, "John Doe", author.Name)
+}
+
+func TestRouteManager_RecordsActors(t *testing.T) {
+	router := NewRouteManager(database.NewMemoryStore())
+	uuid, err := router.CreateAuthor(metadata.WithActor(context.Background(), "creator"),
+		&authorManagementProto.Author{Name: "John Doe", CreatedBy: "spoofed"})
+	assert.NoError(t, err)
+	err = router.UpdateAuthor(metadata.WithActor(context.Background(), "editor"),
+		&authorManagementProto.Author{Uuid: &uuid, Name: "Jane Doe"}, nil)
+	assert.NoError(t, err)
+	author, err := router.GetAuthor(context.Background(), uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, "creator", author.CreatedBy)
+	assert.Equal(t, "editor", author.UpdatedBy)
+	assert.NotNil(t, author.CreatedAt)
+	assert.NotNil(t, author.UpdatedAt)
+}
+
+func TestRouteManager_GetAuthorWithoutUUID(t *testing.T) {
+	router := NewRouteManager(database.NewMemoryStore())
+	author, err := router.GetAuthor(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingUUID)
+	assert.Nil(t, author)
+}
+
+func TestRouteManager_ListAuthors(t *testing.T) {
+	ctx := context.Background()
+	router := NewRouteManager(database.NewMemoryStore())
+	for i := 0; i < 3; i++ {
+		_, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe"})
+		assert.NoError(t, err)
+	}
+	authors, err := router.ListAuthors(ctx, &authorManagementProto.AuthorQuery{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, authors.Authors, 2)
+	assert.NotEmpty(t, authors.NextPageToken)
+}
+
+func TestRouteManager_DeleteAuthorWithoutUUID(t *testing.T) {
+	router := NewRouteManager(database.NewMemoryStore())
+	err := router.DeleteAuthor(context.Background(), "", 0)
+	assert.ErrorIs(t, err, ErrMissingUUID)
+}
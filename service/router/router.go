@@ -1,85 +1,242 @@
 package router
 
 import (
-	"errors"
+	"context"
+	"log/slog"
+	"service/apperror"
 	"service/database"
+	"service/metrics"
 	"service/utils"
+	"service/validation"
+	"time"
 
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
 	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer Creates the spans of the routed events.
+var tracer = otel.Tracer("service/router")
+
 // RouteManager Object holding the necessary properties of the route manager.
 type RouteManager struct {
-	connector database.DbConnector
+	connector database.AuthorStore
+	validator *validation.Validator
+	metrics   *metrics.Metrics
+	logger    *slog.Logger
+}
+
+// Option Customizes the RouteManager created by NewRouteManager.
+type Option func(rm *RouteManager)
+
+// WithValidator Sets the Validator applied to authors on create and update. When not set the
+// rules of validation.DefaultConfig are used.
+func WithValidator(validator *validation.Validator) Option {
+	return func(rm *RouteManager) {
+		rm.validator = validator
+	}
+}
+
+// WithMetrics Records the action, outcome and duration of every routed event on metrics.
+func WithMetrics(metrics *metrics.Metrics) Option {
+	return func(rm *RouteManager) {
+		rm.metrics = metrics
+	}
+}
+
+// WithLogger Sets the logger of the routed events. When not set slog.Default is used.
+func WithLogger(logger *slog.Logger) Option {
+	return func(rm *RouteManager) {
+		rm.logger = logger
+	}
 }
 
-// NewRouteManager Creates a new RouteManager instance based on passed gorm DbConnector
-func NewRouteManager(connector database.DbConnector) *RouteManager {
-	return &RouteManager{
+// NewRouteManager Creates a new RouteManager instance based on passed AuthorStore.
+func NewRouteManager(connector database.AuthorStore, options ...Option) *RouteManager {
+	rm := &RouteManager{
 		connector: connector,
+		validator: validation.NewValidator(validation.DefaultConfig()),
+		logger:    slog.Default(),
 	}
+	for _, option := range options {
+		option(rm)
+	}
+	return rm
+}
+
+// RouteEvent Process a received event from the message broker, within a span child of the one
+// carried by ctx.
+func (rm *RouteManager) RouteEvent(ctx context.Context, event *eventProto.Event) ([]string, error) {
+	action := ActionName(event)
+	ctx, span := tracer.Start(ctx, "RouteEvent "+action, trace.WithAttributes(attribute.String("event.action", action)))
+	defer span.End()
+	logger := rm.logger.With("action", action, "author_uuid", AuthorKey(event))
+	logger.DebugContext(ctx, "Routing event", "payload", event.Message)
+	start := time.Now()
+	result, err := rm.route(ctx, event)
+	duration := time.Since(start)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, apperror.CodeOf(err).String())
+	}
+	if rm.metrics != nil {
+		rm.metrics.ObserveEvent(action, err, duration)
+	}
+	logRouted(ctx, logger, err, duration)
+	return result, err
 }
 
-// RouteEvent Process a received event from the message broker.
-func (rm *RouteManager) RouteEvent(event *eventProto.Event) ([]string, error) {
+// logRouted Logs the outcome of a routed event. Failures caused by the event, as invalid or
+// missing authors, are logged on the info level, and the ones of the service as errors.
+func logRouted(ctx context.Context, logger *slog.Logger, err error, duration time.Duration) {
+	if err == nil {
+		logger.DebugContext(ctx, "Routed event", "duration", duration)
+		return
+	}
+	level := slog.LevelInfo
+	switch apperror.CodeOf(err) {
+	case apperror.Internal:
+		level = slog.LevelError
+	case apperror.Unavailable:
+		level = slog.LevelWarn
+	}
+	logger.Log(ctx, level, "Failed to route event", "code", apperror.CodeOf(err).String(), "error", err,
+		"duration", duration)
+}
+
+// ActionName Returns the name of the action of the event, RESTORE for the DELETE events restoring
+// the author.
+func ActionName(event *eventProto.Event) string {
+	if event.Action == eventProto.Action_DELETE {
+		if query, err := utils.DecodeAuthorQuery(event.Message); err == nil && query.Restore {
+			return "RESTORE"
+		}
+	}
+	return event.Action.String()
+}
+
+// route Routes the event to the operation of its action.
+func (rm *RouteManager) route(ctx context.Context, event *eventProto.Event) ([]string, error) {
 	switch event.Action {
 	case eventProto.Action_CREATE:
-		return rm.createAuthor(event)
+		return rm.createAuthor(ctx, event)
 	case eventProto.Action_UPDATE:
-		return rm.updateAuthor(event)
+		return rm.updateAuthor(ctx, event)
 	case eventProto.Action_READ:
-		return rm.readAuthor(event)
+		return rm.readAuthor(ctx, event)
 	case eventProto.Action_DELETE:
-		return rm.deleteAuthor(event)
+		return rm.deleteAuthor(ctx, event)
+	}
+	return nil, apperror.New(apperror.InvalidArgument, "action not supported")
+}
+
+// AuthorKey Returns the uuid of the author the event targets, so events of the same author can be
+// processed in order. Empty when the event targets no author, as creations without uuid and
+// listings, or its message can't be decoded.
+func AuthorKey(event *eventProto.Event) string {
+	switch event.Action {
+	case eventProto.Action_CREATE, eventProto.Action_UPDATE:
+		// AuthorUpdate shares the uuid field with Author.
+		author, err := utils.DecodeAuthor(event.Message)
+		if err != nil {
+			return ""
+		}
+		return author.GetUuid()
+	case eventProto.Action_READ, eventProto.Action_DELETE:
+		query, err := utils.DecodeAuthorQuery(event.Message)
+		if err != nil || query.AllEntries {
+			return ""
+		}
+		return query.GetUuid()
 	}
-	return nil, errors.New("action not supported")
+	return ""
 }
 
 // createAuthor Creates an author from the information passed on the event.
-func (rm *RouteManager) createAuthor(event *eventProto.Event) ([]string, error) {
-	author := utils.DecodeAuthor(event.Message)
-	uuid, err := rm.connector.AddAuthor(database.AuthorFromGrpc(author))
-	parsedUUID := uuid.String()
-	return []string{parsedUUID}, err
+func (rm *RouteManager) createAuthor(ctx context.Context, event *eventProto.Event) ([]string, error) {
+	author, err := utils.DecodeAuthor(event.Message)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := rm.CreateAuthor(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+	return []string{uuid}, nil
 }
 
-// updateAuthor Updates an author with the new data passed on the event.
-func (rm *RouteManager) updateAuthor(event *eventProto.Event) ([]string, error) {
-	author := utils.DecodeAuthor(event.Message)
-	err := rm.connector.UpdateAuthor(database.AuthorFromGrpc(author))
+// updateAuthor Updates an author with the new data passed on the event, honoring its update
+// mask.
+func (rm *RouteManager) updateAuthor(ctx context.Context, event *eventProto.Event) ([]string, error) {
+	update, err := utils.DecodeAuthorUpdate(event.Message)
+	if err != nil {
+		return nil, err
+	}
+	author := &authorManagementProto.Author{
+		Uuid:    update.Uuid,
+		Name:    update.Name,
+		PicUrl:  update.PicUrl,
+		Version: update.Version,
+	}
+	err = rm.UpdateAuthor(ctx, author, update.UpdateMask)
 	return nil, err
 }
 
-// readAuthor Reads one or all authors from the database.
-func (rm *RouteManager) readAuthor(event *eventProto.Event) ([]string, error) {
-	query := utils.DecodeQuery(event.Message)
+// readAuthor Reads one author, its history or a page of authors from the database.
+func (rm *RouteManager) readAuthor(ctx context.Context, event *eventProto.Event) ([]string, error) {
+	query, err := utils.DecodeAuthorQuery(event.Message)
+	if err != nil {
+		return nil, err
+	}
 	if query.AllEntries {
-		return rm.readAllAuthors()
+		return rm.readAllAuthors(ctx, query)
+	}
+	if query.History {
+		return rm.readAuthorHistory(ctx, query.GetUuid())
 	}
-	return rm.readAuthorByID(query.GetUuid())
+	return rm.readAuthorByID(ctx, query.GetUuid())
 }
 
-// readAuthorByID Reads an author by the passed ID.
-func (rm *RouteManager) readAuthorByID(uuid string) ([]string, error) {
-	author, err := rm.connector.GetAuthor(uuid)
-	parsedAuthor := database.AuthorToGrpc(*author)
-	return []string{utils.EncodeAuthorToString(parsedAuthor)}, err
+// readAuthorHistory Reads the changes made to the author with the passed ID.
+func (rm *RouteManager) readAuthorHistory(ctx context.Context, uuid string) ([]string, error) {
+	history, err := rm.GetAuthorHistory(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return []string{utils.EncodeAuthorHistoryToString(history)}, nil
 }
 
-// readAllAuthors Reads all authors from the database.
-func (rm *RouteManager) readAllAuthors() ([]string, error) {
-	authors := rm.connector.GetAuthors()
-	parsedAuthors := database.AuthorListToGrpcList(authors)
-	return []string{utils.EncodeAuthorsListToString(&parsedAuthors)}, nil
+// readAuthorByID Reads an author by the passed ID.
+func (rm *RouteManager) readAuthorByID(ctx context.Context, uuid string) ([]string, error) {
+	author, err := rm.GetAuthor(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return []string{utils.EncodeAuthorToString(author)}, nil
 }
 
-// deleteAuthor Deletes one author from the database in case of a valid ID.
-func (rm *RouteManager) deleteAuthor(event *eventProto.Event) ([]string, error) {
-	query := utils.DecodeQuery(event.Message)
-	if query.Uuid == nil {
-		return nil, errors.New("uuid not set on the request")
+// readAllAuthors Reads a page of authors from the database using the pagination and filters
+// of the query.
+func (rm *RouteManager) readAllAuthors(ctx context.Context, query *authorManagementProto.AuthorQuery) ([]string, error) {
+	authors, err := rm.ListAuthors(ctx, query)
+	if err != nil {
+		return nil, err
 	}
+	return []string{utils.EncodeAuthorsListToString(authors)}, nil
+}
 
-	err := rm.connector.DeleteAuthor(query.GetUuid())
-	return nil, err
+// deleteAuthor Deletes one author from the database in case of a valid ID and version, or restores
+// it when the query has restore set.
+func (rm *RouteManager) deleteAuthor(ctx context.Context, event *eventProto.Event) ([]string, error) {
+	query, err := utils.DecodeAuthorQuery(event.Message)
+	if err != nil {
+		return nil, err
+	}
+	if query.Restore {
+		return nil, rm.RestoreAuthor(ctx, query.GetUuid())
+	}
+	return nil, rm.DeleteAuthor(ctx, query.GetUuid(), query.Version)
 }
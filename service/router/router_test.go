@@ -1,22 +1,33 @@
 package router
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
 	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
-	"gorm.io/driver/sqlite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"net/http/httptest"
+	"service/apperror"
 	"service/database"
+	"service/logging"
+	"service/metadata"
+	"service/metrics"
 	"service/utils"
 	"testing"
 )
 
 func TestRouteManager_CreateEvent(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -30,15 +41,14 @@ func TestRouteManager_CreateEvent(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 }
 
 func TestRouteManager_UpdateEvent(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -52,7 +62,7 @@ func TestRouteManager_UpdateEvent(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 
@@ -67,15 +77,47 @@ func TestRouteManager_UpdateEvent(t *testing.T) {
 		Message: newAuthorString,
 	}
 
-	result, err = router.RouteEvent(&updateEvent)
+	result, err = router.RouteEvent(ctx, &updateEvent)
 	assert.Nil(t, result)
 	assert.NoError(t, err)
 }
 
+func TestRouteManager_UpdateEventWithMask(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	picURL := "https://example.com/johndoe.png"
+	router := NewRouteManager(db)
+	newUUID, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe", PicUrl: &picURL})
+	assert.NoError(t, err)
+
+	emptyPicURL := ""
+	update := authorManagementProto.AuthorUpdate{
+		Uuid:       &newUUID,
+		Name:       "Jane Doe",
+		PicUrl:     &emptyPicURL,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"picUrl"}},
+	}
+	updateEvent := eventProto.Event{
+		Action:  eventProto.Action_UPDATE,
+		Message: utils.EncodeAuthorUpdateToString(&update),
+	}
+	_, err = router.RouteEvent(ctx, &updateEvent)
+	assert.NoError(t, err)
+
+	author, err := router.GetAuthor(ctx, newUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", author.Name)
+	assert.Nil(t, author.PicUrl)
+
+	update.UpdateMask.Paths = []string{"name", "age"}
+	updateEvent.Message = utils.EncodeAuthorUpdateToString(&update)
+	_, err = router.RouteEvent(ctx, &updateEvent)
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+}
+
 func TestRouteManager_ReadEvent(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -89,7 +131,7 @@ func TestRouteManager_ReadEvent(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 
@@ -104,8 +146,9 @@ func TestRouteManager_ReadEvent(t *testing.T) {
 		Message: queryString,
 	}
 
-	result, err = router.RouteEvent(&readEvent)
-	receivedAuthor := utils.DecodeAuthor(result[0])
+	result, err = router.RouteEvent(ctx, &readEvent)
+	assert.NoError(t, err)
+	receivedAuthor, err := utils.DecodeAuthor(result[0])
 	assert.NoError(t, err)
 	assert.Equal(t, author.Name, receivedAuthor.Name)
 	assert.Equal(t, author.Uuid, receivedAuthor.Uuid)
@@ -113,9 +156,8 @@ func TestRouteManager_ReadEvent(t *testing.T) {
 }
 
 func TestRouteManager_ReadAllEvent(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -129,7 +171,7 @@ func TestRouteManager_ReadAllEvent(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 
@@ -143,7 +185,7 @@ func TestRouteManager_ReadAllEvent(t *testing.T) {
 		Message: queryString,
 	}
 
-	result, err = router.RouteEvent(&readEvent)
+	result, err = router.RouteEvent(ctx, &readEvent)
 	decoded, _ := base64.StdEncoding.DecodeString(result[0])
 	authorList := &authorManagementProto.AuthorList{}
 	proto.Unmarshal(decoded, authorList)
@@ -153,9 +195,8 @@ func TestRouteManager_ReadAllEvent(t *testing.T) {
 }
 
 func TestRouteManager_DeleteEvent(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -169,7 +210,7 @@ func TestRouteManager_DeleteEvent(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 
@@ -183,15 +224,105 @@ func TestRouteManager_DeleteEvent(t *testing.T) {
 		Action:  eventProto.Action_DELETE,
 		Message: queryString,
 	}
-	result, err = router.RouteEvent(&event)
+	result, err = router.RouteEvent(ctx, &event)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestRouteManager_EventsWithVersion(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	router := NewRouteManager(db)
+	newUUID, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+
+	update := authorManagementProto.AuthorUpdate{Uuid: &newUUID, Name: "Jane Doe", Version: 1}
+	updateEvent := eventProto.Event{
+		Action:  eventProto.Action_UPDATE,
+		Message: utils.EncodeAuthorUpdateToString(&update),
+	}
+	_, err = router.RouteEvent(ctx, &updateEvent)
+	assert.NoError(t, err)
+	_, err = router.RouteEvent(ctx, &updateEvent)
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+	assert.Equal(t, apperror.Conflict, apperror.CodeOf(err))
+
+	author, err := router.GetAuthor(ctx, newUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), author.Version)
+
+	query := authorManagementProto.AuthorQuery{Uuid: &newUUID, Version: 1}
+	byteQuery, _ := proto.Marshal(&query)
+	deleteEvent := eventProto.Event{
+		Action:  eventProto.Action_DELETE,
+		Message: base64.StdEncoding.EncodeToString(byteQuery),
+	}
+	_, err = router.RouteEvent(ctx, &deleteEvent)
+	assert.ErrorIs(t, err, database.ErrVersionConflict)
+	query.Version = 2
+	byteQuery, _ = proto.Marshal(&query)
+	deleteEvent.Message = base64.StdEncoding.EncodeToString(byteQuery)
+	_, err = router.RouteEvent(ctx, &deleteEvent)
+	assert.NoError(t, err)
+}
+
+func TestRouteManager_RestoreEvent(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	router := NewRouteManager(db)
+	newUUID, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	query := authorManagementProto.AuthorQuery{Uuid: &newUUID}
+	byteQuery, _ := proto.Marshal(&query)
+	queryString := base64.StdEncoding.EncodeToString(byteQuery)
+
+	_, err = router.RouteEvent(ctx, &eventProto.Event{Action: eventProto.Action_DELETE, Message: queryString})
+	assert.NoError(t, err)
+	_, err = router.GetAuthor(ctx, newUUID)
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+
+	query.Restore = true
+	byteQuery, _ = proto.Marshal(&query)
+	restoreString := base64.StdEncoding.EncodeToString(byteQuery)
+	assert.Equal(t, "RESTORE", ActionName(&eventProto.Event{Action: eventProto.Action_DELETE, Message: restoreString}))
+	assert.Equal(t, "DELETE", ActionName(&eventProto.Event{Action: eventProto.Action_DELETE, Message: queryString}))
+	result, err := router.RouteEvent(ctx, &eventProto.Event{Action: eventProto.Action_DELETE, Message: restoreString})
 	assert.NoError(t, err)
 	assert.Nil(t, result)
+	author, err := router.GetAuthor(ctx, newUUID)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", author.Name)
+}
+
+func TestRouteManager_ReadHistoryEvent(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := metadata.WithCorrelationID(metadata.WithActor(context.Background(), "editor"), "42")
+	router := NewRouteManager(db)
+	newUUID, err := router.CreateAuthor(ctx, &authorManagementProto.Author{Name: "John Doe"})
+	assert.NoError(t, err)
+	err = router.UpdateAuthor(ctx, &authorManagementProto.Author{Uuid: &newUUID, Name: "Jane Doe"}, nil)
+	assert.NoError(t, err)
+	query := authorManagementProto.AuthorQuery{Uuid: &newUUID, History: true}
+	byteQuery, _ := proto.Marshal(&query)
+	queryString := base64.StdEncoding.EncodeToString(byteQuery)
+
+	result, err := router.RouteEvent(ctx, &eventProto.Event{Action: eventProto.Action_READ, Message: queryString})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	decoded, _ := base64.StdEncoding.DecodeString(result[0])
+	history := &authorManagementProto.AuthorHistory{}
+	assert.NoError(t, proto.Unmarshal(decoded, history))
+	assert.Len(t, history.Entries, 2)
+	assert.Equal(t, authorManagementProto.AuditEntry_UPDATE, history.Entries[1].Operation)
+	assert.Equal(t, "John Doe", history.Entries[1].Before.Name)
+	assert.Equal(t, "Jane Doe", history.Entries[1].After.Name)
+	assert.Equal(t, "editor", history.Entries[1].Actor)
+	assert.Equal(t, "42", history.Entries[1].CorrelationId)
 }
 
 func TestRouteManager_DeleteEventWithoutUUID(t *testing.T) {
-	sqliteDialector := sqlite.Open("file::memory:?cache=shared")
-	db := database.NewConnection(sqliteDialector)
-	defer db.CloseDatabase()
+	db := database.NewMemoryStore()
+	ctx := context.Background()
 	newUUID := uuid.NewString()
 	author := authorManagementProto.Author{
 		Uuid:   &newUUID,
@@ -205,7 +336,7 @@ func TestRouteManager_DeleteEventWithoutUUID(t *testing.T) {
 	}
 
 	router := NewRouteManager(db)
-	result, err := router.RouteEvent(&event)
+	result, err := router.RouteEvent(ctx, &event)
 	assert.NoError(t, err)
 	assert.Equal(t, newUUID, result[0])
 
@@ -218,7 +349,259 @@ func TestRouteManager_DeleteEventWithoutUUID(t *testing.T) {
 		Action:  eventProto.Action_DELETE,
 		Message: queryString,
 	}
-	result, err = router.RouteEvent(&event)
+	result, err = router.RouteEvent(ctx, &event)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 }
+
+func TestRouteManager_ReadEventNonExistentAuthor(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	newUUID := uuid.NewString()
+	query := eventProto.Query{
+		Uuid:       &newUUID,
+		AllEntries: false,
+	}
+	byteQuery, _ := proto.Marshal(&query)
+	readEvent := eventProto.Event{
+		Action:  eventProto.Action_READ,
+		Message: base64.StdEncoding.EncodeToString(byteQuery),
+	}
+
+	router := NewRouteManager(db)
+	result, err := router.RouteEvent(ctx, &readEvent)
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+	assert.Nil(t, result)
+}
+
+func TestRouteManager_ReadAllEventPaginated(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	router := NewRouteManager(db)
+	for _, name := range []string{"John Doe", "Jane Doe", "Mary Major"} {
+		newUUID := uuid.NewString()
+		author := authorManagementProto.Author{Uuid: &newUUID, Name: name}
+		event := eventProto.Event{
+			Action:  eventProto.Action_CREATE,
+			Message: utils.EncodeAuthorToString(&author),
+		}
+		_, err := router.RouteEvent(ctx, &event)
+		assert.NoError(t, err)
+	}
+
+	query := authorManagementProto.AuthorQuery{
+		AllEntries:   true,
+		PageSize:     1,
+		OrderBy:      authorManagementProto.AuthorQuery_ORDER_BY_NAME,
+		NameContains: "doe",
+	}
+	var names []string
+	for {
+		byteQuery, _ := proto.Marshal(&query)
+		readEvent := eventProto.Event{
+			Action:  eventProto.Action_READ,
+			Message: base64.StdEncoding.EncodeToString(byteQuery),
+		}
+		result, err := router.RouteEvent(ctx, &readEvent)
+		assert.NoError(t, err)
+		decoded, _ := base64.StdEncoding.DecodeString(result[0])
+		authorList := &authorManagementProto.AuthorList{}
+		proto.Unmarshal(decoded, authorList)
+		for _, author := range authorList.Authors {
+			names = append(names, author.Name)
+		}
+		if authorList.NextPageToken == "" {
+			break
+		}
+		query.PageToken = authorList.NextPageToken
+	}
+	assert.Equal(t, []string{"Jane Doe", "John Doe"}, names)
+}
+
+func TestRouteManager_CreateEventWithoutUUID(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	author := authorManagementProto.Author{Name: "John Doe"}
+	event := eventProto.Event{
+		Action:  eventProto.Action_CREATE,
+		Message: utils.EncodeAuthorToString(&author),
+	}
+
+	router := NewRouteManager(db)
+	result, err := router.RouteEvent(ctx, &event)
+	assert.NoError(t, err)
+	_, parseErr := uuid.Parse(result[0])
+	assert.NoError(t, parseErr)
+}
+
+func TestRouteManager_CreateEventWithoutName(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	author := authorManagementProto.Author{Name: "  "}
+	event := eventProto.Event{
+		Action:  eventProto.Action_CREATE,
+		Message: utils.EncodeAuthorToString(&author),
+	}
+
+	router := NewRouteManager(db)
+	result, err := router.RouteEvent(ctx, &event)
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	assert.Equal(t, "name", apperror.ViolationsOf(err)[0].Field)
+	assert.Nil(t, result)
+}
+
+func TestRouteManager_CreateEventWithInvalidUUID(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	invalidUUID := "not-an-uuid"
+	author := authorManagementProto.Author{Uuid: &invalidUUID, Name: "John Doe"}
+	event := eventProto.Event{
+		Action:  eventProto.Action_CREATE,
+		Message: utils.EncodeAuthorToString(&author),
+	}
+
+	router := NewRouteManager(db)
+	result, err := router.RouteEvent(ctx, &event)
+	assert.ErrorIs(t, err, database.ErrInvalidUUID)
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	assert.Nil(t, result)
+}
+
+func TestRouteManager_UpdateEventWithInvalidUUID(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	invalidUUID := uuid.NewString() + "x"
+	author := authorManagementProto.Author{Uuid: &invalidUUID, Name: "John Doe"}
+	event := eventProto.Event{
+		Action:  eventProto.Action_UPDATE,
+		Message: utils.EncodeAuthorToString(&author),
+	}
+
+	router := NewRouteManager(db)
+	_, err := router.RouteEvent(ctx, &event)
+	assert.ErrorIs(t, err, database.ErrInvalidUUID)
+}
+
+func TestRouteManager_EventsWithUndecodableMessage(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	router := NewRouteManager(db)
+	actions := []eventProto.Action{
+		eventProto.Action_CREATE,
+		eventProto.Action_UPDATE,
+		eventProto.Action_READ,
+		eventProto.Action_DELETE,
+	}
+	for _, action := range actions {
+		event := eventProto.Event{
+			Action:  action,
+			Message: "not base64!",
+		}
+		result, err := router.RouteEvent(ctx, &event)
+		assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err), "action %s", action)
+		assert.Nil(t, result)
+	}
+	page, _ := db.ListAuthors(ctx, database.ListOptions{})
+	assert.Empty(t, page.Authors)
+}
+
+func TestAuthorKey(t *testing.T) {
+	newUUID := uuid.NewString()
+	author := utils.EncodeAuthorToString(&authorManagementProto.Author{Uuid: &newUUID, Name: "John Doe"})
+	update := utils.EncodeAuthorUpdateToString(&authorManagementProto.AuthorUpdate{Uuid: &newUUID, Name: "Jane Doe"})
+	byteQuery, _ := proto.Marshal(&authorManagementProto.AuthorQuery{Uuid: &newUUID})
+	query := base64.StdEncoding.EncodeToString(byteQuery)
+	byteListing, _ := proto.Marshal(&authorManagementProto.AuthorQuery{AllEntries: true})
+	listing := base64.StdEncoding.EncodeToString(byteListing)
+
+	assert.Equal(t, newUUID, AuthorKey(&eventProto.Event{Action: eventProto.Action_CREATE, Message: author}))
+	assert.Equal(t, newUUID, AuthorKey(&eventProto.Event{Action: eventProto.Action_UPDATE, Message: update}))
+	assert.Equal(t, newUUID, AuthorKey(&eventProto.Event{Action: eventProto.Action_READ, Message: query}))
+	assert.Equal(t, newUUID, AuthorKey(&eventProto.Event{Action: eventProto.Action_DELETE, Message: query}))
+	assert.Empty(t, AuthorKey(&eventProto.Event{Action: eventProto.Action_READ, Message: listing}))
+	assert.Empty(t, AuthorKey(&eventProto.Event{Action: eventProto.Action_CREATE,
+		Message: utils.EncodeAuthorToString(&authorManagementProto.Author{Name: "John Doe"})}))
+	assert.Empty(t, AuthorKey(&eventProto.Event{Action: eventProto.Action_DELETE, Message: "not base64"}))
+	assert.Empty(t, AuthorKey(&eventProto.Event{Action: eventProto.Action(42), Message: query}))
+}
+
+func TestRouteManager_WithMetrics(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := context.Background()
+	newUUID := uuid.NewString()
+	author := authorManagementProto.Author{
+		Uuid: &newUUID,
+		Name: "John Doe",
+	}
+	recorder := metrics.New()
+	router := NewRouteManager(db, WithMetrics(recorder))
+	_, err := router.RouteEvent(ctx, &eventProto.Event{
+		Action:  eventProto.Action_CREATE,
+		Message: utils.EncodeAuthorToString(&author),
+	})
+	assert.NoError(t, err)
+	missingUUID := uuid.NewString()
+	query := authorManagementProto.AuthorQuery{Uuid: &missingUUID, Restore: true}
+	byteQuery, _ := proto.Marshal(&query)
+	_, err = router.RouteEvent(ctx, &eventProto.Event{
+		Action:  eventProto.Action_DELETE,
+		Message: base64.StdEncoding.EncodeToString(byteQuery),
+	})
+	assert.Error(t, err)
+
+	mux := http.NewServeMux()
+	recorder.Register(mux)
+	response := httptest.NewRecorder()
+	mux.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, response.Body.String(), `author_management_events_total{action="CREATE",outcome="OK"} 1`)
+	assert.Contains(t, response.Body.String(), `author_management_events_total{action="RESTORE",outcome="NOT_FOUND"} 1`)
+}
+
+func TestRouteManager_Tracing(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	db := database.NewMemoryStore()
+	router := NewRouteManager(db)
+	parent, parentSpan := otel.Tracer("test").Start(context.Background(), "consume")
+	missingUUID := uuid.NewString()
+	query := authorManagementProto.AuthorQuery{Uuid: &missingUUID}
+	byteQuery, _ := proto.Marshal(&query)
+	_, err := router.RouteEvent(parent, &eventProto.Event{
+		Action:  eventProto.Action_READ,
+		Message: base64.StdEncoding.EncodeToString(byteQuery),
+	})
+	assert.Error(t, err)
+	parentSpan.End()
+
+	ended := spans.Ended()
+	assert.Len(t, ended, 2)
+	span := ended[0]
+	assert.Equal(t, "RouteEvent READ", span.Name())
+	assert.Equal(t, parentSpan.SpanContext().SpanID(), span.Parent().SpanID())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Equal(t, "NOT_FOUND", span.Status().Description)
+}
+
+func TestRouteManager_WithLogger(t *testing.T) {
+	db := database.NewMemoryStore()
+	ctx := metadata.WithCorrelationID(context.Background(), "42")
+	output := &bytes.Buffer{}
+	logger, err := logging.New(output, logging.Config{Level: "debug", Format: "text"})
+	assert.NoError(t, err)
+	router := NewRouteManager(db, WithLogger(logger))
+	missingUUID := uuid.NewString()
+	query := authorManagementProto.AuthorQuery{Uuid: &missingUUID}
+	byteQuery, _ := proto.Marshal(&query)
+	_, err = router.RouteEvent(ctx, &eventProto.Event{
+		Action:  eventProto.Action_READ,
+		Message: base64.StdEncoding.EncodeToString(byteQuery),
+	})
+	assert.Error(t, err)
+
+	assert.Contains(t, output.String(), `level=DEBUG msg="Routing event" action=READ author_uuid=`+missingUUID+
+		` payload=[REDACTED] correlation_id=42`)
+	assert.Contains(t, output.String(), `level=INFO msg="Failed to route event" action=READ author_uuid=`+missingUUID+
+		` code=NOT_FOUND`)
+}
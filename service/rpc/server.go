@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"context"
+	"service/apperror"
+	"service/metadata"
+	"service/router"
+
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AuthorServer gRPC implementation of the AuthorService, sharing the RouteManager used by the
+// message broker consumer so both transports behave the same way.
+type AuthorServer struct {
+	authorManagementProto.UnimplementedAuthorServiceServer
+	routeManager *router.RouteManager
+}
+
+// NewAuthorServer Creates a new AuthorServer backed by the passed RouteManager.
+func NewAuthorServer(routeManager *router.RouteManager) *AuthorServer {
+	return &AuthorServer{
+		routeManager: routeManager,
+	}
+}
+
+// NewGrpcServer Creates a gRPC server with the AuthorService registered.
+func NewGrpcServer(routeManager *router.RouteManager) *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(metadataInterceptor))
+	authorManagementProto.RegisterAuthorServiceServer(server, NewAuthorServer(routeManager))
+	return server
+}
+
+// metadataInterceptor Adds the actor and correlation id sent on the request metadata to the
+// context of the call.
+func metadataInterceptor(ctx context.Context, request interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	incoming, _ := grpcMetadata.FromIncomingContext(ctx)
+	if values := incoming.Get(metadata.ActorHeader); len(values) > 0 {
+		ctx = metadata.WithActor(ctx, values[0])
+	}
+	if values := incoming.Get(metadata.CorrelationIDHeader); len(values) > 0 {
+		ctx = metadata.WithCorrelationID(ctx, values[0])
+	}
+	return handler(ctx, request)
+}
+
+// errMissingAuthor Returned when a request that needs an author has none.
+var errMissingAuthor = apperror.New(apperror.InvalidArgument, "author not set on the request")
+
+// grpcCodes Maps the service error codes into gRPC status codes.
+var grpcCodes = map[apperror.Code]codes.Code{
+	apperror.Internal:        codes.Internal,
+	apperror.NotFound:        codes.NotFound,
+	apperror.AlreadyExists:   codes.AlreadyExists,
+	apperror.InvalidArgument: codes.InvalidArgument,
+	apperror.Unavailable:     codes.Unavailable,
+	apperror.Conflict:        codes.Aborted,
+}
+
+// toStatus Converts the errors returned by the RouteManager into gRPC status errors. Field
+// violations are sent as a BadRequest detail.
+func toStatus(err error) error {
+	grpcStatus := status.New(grpcCodes[apperror.CodeOf(err)], apperror.MessageOf(err))
+	violations := apperror.ViolationsOf(err)
+	if len(violations) == 0 {
+		return grpcStatus.Err()
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	detailed, detailsErr := grpcStatus.WithDetails(badRequest)
+	if detailsErr != nil {
+		return grpcStatus.Err()
+	}
+	return detailed.Err()
+}
+
+// CreateAuthor Creates a new author and returns it with the uuid it was stored with.
+func (server *AuthorServer) CreateAuthor(ctx context.Context, request *authorManagementProto.CreateAuthorRequest) (*authorManagementProto.Author, error) {
+	if request.Author == nil {
+		return nil, toStatus(errMissingAuthor)
+	}
+	uuid, err := server.routeManager.CreateAuthor(ctx, request.Author)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return server.GetAuthor(ctx, &authorManagementProto.GetAuthorRequest{Uuid: uuid})
+}
+
+// GetAuthor Returns the author registered with the requested uuid.
+func (server *AuthorServer) GetAuthor(ctx context.Context, request *authorManagementProto.GetAuthorRequest) (*authorManagementProto.Author, error) {
+	author, err := server.routeManager.GetAuthor(ctx, request.Uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return author, nil
+}
+
+// ListAuthors Returns a page of authors matching the query.
+func (server *AuthorServer) ListAuthors(ctx context.Context, query *authorManagementProto.AuthorQuery) (*authorManagementProto.AuthorList, error) {
+	authors, err := server.routeManager.ListAuthors(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return authors, nil
+}
+
+// UpdateAuthor Updates the fields of an author set on the update mask and returns its new state.
+func (server *AuthorServer) UpdateAuthor(ctx context.Context, request *authorManagementProto.UpdateAuthorRequest) (*authorManagementProto.Author, error) {
+	if request.Author == nil || request.Author.Uuid == nil {
+		return nil, toStatus(router.ErrMissingUUID)
+	}
+	err := server.routeManager.UpdateAuthor(ctx, request.Author, request.UpdateMask)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return server.GetAuthor(ctx, &authorManagementProto.GetAuthorRequest{Uuid: request.Author.GetUuid()})
+}
+
+// DeleteAuthor Deletes the author registered with the requested uuid.
+func (server *AuthorServer) DeleteAuthor(ctx context.Context, request *authorManagementProto.DeleteAuthorRequest) (*emptypb.Empty, error) {
+	err := server.routeManager.DeleteAuthor(ctx, request.Uuid, request.Version)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreAuthor Restores the soft deleted author registered with the requested uuid and returns
+// it.
+func (server *AuthorServer) RestoreAuthor(ctx context.Context, request *authorManagementProto.RestoreAuthorRequest) (*authorManagementProto.Author, error) {
+	err := server.routeManager.RestoreAuthor(ctx, request.Uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return server.GetAuthor(ctx, &authorManagementProto.GetAuthorRequest{Uuid: request.Uuid})
+}
+
+// GetAuthorHistory Returns the changes made to the author registered with the requested uuid.
+func (server *AuthorServer) GetAuthorHistory(ctx context.Context, request *authorManagementProto.GetAuthorHistoryRequest) (*authorManagementProto.AuthorHistory, error) {
+	history, err := server.routeManager.GetAuthorHistory(ctx, request.Uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return history, nil
+}
@@ -0,0 +1,256 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcMetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net"
+	"service/apperror"
+	"service/database"
+	"service/metadata"
+	"service/router"
+	"testing"
+)
+
+// newTestClient Starts an in process gRPC server backed by a MemoryStore and returns a client
+// connected to it.
+func newTestClient(t *testing.T) authorManagementProto.AuthorServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewGrpcServer(router.NewRouteManager(database.NewMemoryStore()))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	connection, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { connection.Close() })
+	return authorManagementProto.NewAuthorServiceClient(connection)
+}
+
+func TestAuthorServer_CreateAndGetAuthor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	picURL := "https://example.com/johndoe.png"
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe", PicUrl: &picURL},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.GetUuid())
+
+	author, err := client.GetAuthor(ctx, &authorManagementProto.GetAuthorRequest{Uuid: created.GetUuid()})
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", author.Name)
+	assert.Equal(t, picURL, author.GetPicUrl())
+}
+
+func TestAuthorServer_CreateDuplicatedAuthor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	newUUID := uuid.NewString()
+	request := &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Uuid: &newUUID, Name: "John Doe"},
+	}
+	_, err := client.CreateAuthor(ctx, request)
+	assert.NoError(t, err)
+	_, err = client.CreateAuthor(ctx, request)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestAuthorServer_CreateWithoutAuthor(t *testing.T) {
+	client := newTestClient(t)
+	_, err := client.CreateAuthor(context.Background(), &authorManagementProto.CreateAuthorRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthorServer_GetNonExistentAuthor(t *testing.T) {
+	client := newTestClient(t)
+	_, err := client.GetAuthor(context.Background(), &authorManagementProto.GetAuthorRequest{Uuid: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthorServer_ListAuthors(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	for _, name := range []string{"John Doe", "Jane Doe", "Mary Major"} {
+		_, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+			Author: &authorManagementProto.Author{Name: name},
+		})
+		assert.NoError(t, err)
+	}
+	authors, err := client.ListAuthors(ctx, &authorManagementProto.AuthorQuery{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, authors.Authors, 2)
+	assert.NotEmpty(t, authors.NextPageToken)
+
+	_, err = client.ListAuthors(ctx, &authorManagementProto.AuthorQuery{PageToken: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthorServer_UpdateAuthor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+	picURL := "https://example.com/new.png"
+	updated, err := client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{
+		Author: &authorManagementProto.Author{Uuid: created.Uuid, PicUrl: &picURL},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", updated.Name)
+	assert.Equal(t, picURL, updated.GetPicUrl())
+
+	_, err = client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthorServer_UpdateAuthorWithMask(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	picURL := "https://example.com/johndoe.png"
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe", PicUrl: &picURL},
+	})
+	assert.NoError(t, err)
+	updated, err := client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{
+		Author:     &authorManagementProto.Author{Uuid: created.Uuid, Name: "Jane Doe"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"picUrl"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", updated.Name)
+	assert.Nil(t, updated.PicUrl)
+
+	_, err = client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{
+		Author:     &authorManagementProto.Author{Uuid: created.Uuid},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthorServer_UpdateAuthorWithVersion(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), created.Version)
+	created.Name = "Jane Doe"
+	updated, err := client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{Author: created})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), updated.Version)
+
+	_, err = client.UpdateAuthor(ctx, &authorManagementProto.UpdateAuthorRequest{Author: created})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = client.DeleteAuthor(ctx, &authorManagementProto.DeleteAuthorRequest{Uuid: created.GetUuid(), Version: 1})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = client.DeleteAuthor(ctx, &authorManagementProto.DeleteAuthorRequest{Uuid: created.GetUuid(), Version: 2})
+	assert.NoError(t, err)
+}
+
+func TestAuthorServer_RecordsActor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := grpcMetadata.AppendToOutgoingContext(context.Background(), metadata.ActorHeader, "billing-service")
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "billing-service", created.CreatedBy)
+	assert.Equal(t, "billing-service", created.UpdatedBy)
+	assert.NotNil(t, created.CreatedAt)
+}
+
+func TestAuthorServer_RestoreAuthor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+	_, err = client.DeleteAuthor(ctx, &authorManagementProto.DeleteAuthorRequest{Uuid: created.GetUuid()})
+	assert.NoError(t, err)
+
+	authors, err := client.ListAuthors(ctx, &authorManagementProto.AuthorQuery{IncludeDeleted: true})
+	assert.NoError(t, err)
+	assert.Len(t, authors.Authors, 1)
+	assert.NotNil(t, authors.Authors[0].DeletedAt)
+
+	restored, err := client.RestoreAuthor(ctx, &authorManagementProto.RestoreAuthorRequest{Uuid: created.GetUuid()})
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", restored.Name)
+	assert.Nil(t, restored.DeletedAt)
+	_, err = client.RestoreAuthor(ctx, &authorManagementProto.RestoreAuthorRequest{Uuid: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthorServer_GetAuthorHistory(t *testing.T) {
+	client := newTestClient(t)
+	ctx := grpcMetadata.AppendToOutgoingContext(context.Background(),
+		metadata.ActorHeader, "billing-service", metadata.CorrelationIDHeader, "42")
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+
+	history, err := client.GetAuthorHistory(ctx, &authorManagementProto.GetAuthorHistoryRequest{Uuid: created.GetUuid()})
+	assert.NoError(t, err)
+	assert.Len(t, history.Entries, 1)
+	assert.Equal(t, authorManagementProto.AuditEntry_CREATE, history.Entries[0].Operation)
+	assert.Equal(t, "billing-service", history.Entries[0].Actor)
+	assert.Equal(t, "42", history.Entries[0].CorrelationId)
+	_, err = client.GetAuthorHistory(ctx, &authorManagementProto.GetAuthorHistoryRequest{Uuid: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthorServer_DeleteAuthor(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	created, err := client.CreateAuthor(ctx, &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{Name: "John Doe"},
+	})
+	assert.NoError(t, err)
+	_, err = client.DeleteAuthor(ctx, &authorManagementProto.DeleteAuthorRequest{Uuid: created.GetUuid()})
+	assert.NoError(t, err)
+	_, err = client.GetAuthor(ctx, &authorManagementProto.GetAuthorRequest{Uuid: created.GetUuid()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.DeleteAuthor(ctx, &authorManagementProto.DeleteAuthorRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestToStatus(t *testing.T) {
+	err := toStatus(apperror.Wrap(apperror.Unavailable, "database unavailable", errors.New("connection refused")))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "database unavailable", status.Convert(err).Message())
+	assert.Equal(t, codes.Internal, status.Code(toStatus(errors.New("unexpected"))))
+}
+
+func TestAuthorServer_CreateInvalidAuthor(t *testing.T) {
+	client := newTestClient(t)
+	picURL := "johndoe"
+	_, err := client.CreateAuthor(context.Background(), &authorManagementProto.CreateAuthorRequest{
+		Author: &authorManagementProto.Author{PicUrl: &picURL},
+	})
+	grpcStatus := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, grpcStatus.Code())
+	assert.Len(t, grpcStatus.Details(), 1)
+	badRequest, ok := grpcStatus.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Len(t, badRequest.FieldViolations, 2)
+}
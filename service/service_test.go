@@ -0,0 +1,395 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"service/apperror"
+	"service/broker"
+	"service/config"
+	"service/database"
+	"service/health"
+	"service/idempotency"
+	"service/metrics"
+	"service/router"
+	"service/utils"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// published Message published through a recordingChannel.
+type published struct {
+	exchange string
+	key      string
+	message  amqp.Publishing
+}
+
+// recordingChannel deliveryChannel keeping the messages published through it, failing the ones
+// sent to the exchanges, or the default one for replies, listed on failing.
+type recordingChannel struct {
+	mutex     sync.Mutex
+	published []published
+	failing   map[string]bool
+}
+
+func (channel *recordingChannel) Publish(exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	channel.mutex.Lock()
+	defer channel.mutex.Unlock()
+	if channel.failing[exchange] {
+		return errors.New("channel closed")
+	}
+	channel.published = append(channel.published, published{exchange: exchange, key: key, message: msg})
+	return nil
+}
+
+// to Returns the messages published to the exchange.
+func (channel *recordingChannel) to(exchange string) []amqp.Publishing {
+	channel.mutex.Lock()
+	defer channel.mutex.Unlock()
+	var messages []amqp.Publishing
+	for _, message := range channel.published {
+		if message.exchange == exchange {
+			messages = append(messages, message.message)
+		}
+	}
+	return messages
+}
+
+// recordingAcknowledger amqp.Acknowledger counting the acknowledgements of the deliveries.
+type recordingAcknowledger struct {
+	mutex sync.Mutex
+	acks  int
+	nacks int
+}
+
+func (acknowledger *recordingAcknowledger) Ack(uint64, bool) error {
+	acknowledger.mutex.Lock()
+	defer acknowledger.mutex.Unlock()
+	acknowledger.acks++
+	return nil
+}
+
+func (acknowledger *recordingAcknowledger) Nack(uint64, bool, bool) error {
+	acknowledger.mutex.Lock()
+	defer acknowledger.mutex.Unlock()
+	acknowledger.nacks++
+	return nil
+}
+
+func (acknowledger *recordingAcknowledger) Reject(tag uint64, requeue bool) error {
+	return acknowledger.Nack(tag, false, requeue)
+}
+
+// failingStore AuthorStore failing to add authors with err.
+type failingStore struct {
+	*database.MemoryStore
+	err error
+}
+
+func (store *failingStore) AddAuthor(context.Context, database.Author) (*uuid.UUID, error) {
+	return nil, store.err
+}
+
+// blockingStore AuthorStore adding the authors named slow once release is closed.
+type blockingStore struct {
+	*database.MemoryStore
+	release chan struct{}
+}
+
+func (store *blockingStore) AddAuthor(ctx context.Context, author database.Author) (*uuid.UUID, error) {
+	if author.Name == "slow" {
+		<-store.release
+	}
+	return store.MemoryStore.AddAuthor(ctx, author)
+}
+
+// useSettings Sets the settings used by the session for the duration of the test.
+func useSettings(t *testing.T) {
+	previous := settings
+	settings = &config.Config{
+		QueueName:   "authorQueue",
+		Workers:     2,
+		MaxAttempts: 3,
+	}
+	t.Cleanup(func() { settings = previous })
+}
+
+// discardLogger Returns a logger dropping every record.
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// newTestSession Creates a brokerSession routing the events to the store.
+func newTestSession(store database.AuthorStore, processed database.IdempotencyStore) *brokerSession {
+	return &brokerSession{
+		routeManager: router.NewRouteManager(store),
+		deduplicator: idempotency.NewDeduplicator(processed, time.Hour, time.Hour, discardLogger()),
+		activity:     health.NewActivity(),
+		metrics:      metrics.New(),
+		logger:       discardLogger(),
+	}
+}
+
+// createEvent Returns a CREATE event adding an author with the name.
+func createEvent(name string) *eventProto.Event {
+	message, _ := proto.Marshal(&authorManagementProto.Author{Name: name})
+	return &eventProto.Event{Action: eventProto.Action_CREATE, Message: base64.StdEncoding.EncodeToString(message)}
+}
+
+// newDelivery Returns a delivery of the event, acknowledged through acknowledger.
+func newDelivery(event *eventProto.Event, acknowledger amqp.Acknowledger, headers amqp.Table) amqp.Delivery {
+	body, _ := proto.Marshal(event)
+	return amqp.Delivery{
+		Acknowledger:  acknowledger,
+		Headers:       headers,
+		MessageId:     uuid.NewString(),
+		CorrelationId: "request-1",
+		ReplyTo:       "replies",
+		Body:          body,
+	}
+}
+
+// decodeReply Decodes the reply published to the message, returning whether it succeeded and the
+// code of its error.
+func decodeReply(t *testing.T, message amqp.Publishing) (bool, string) {
+	response := &eventProto.Response{}
+	assert.NoError(t, proto.Unmarshal(message.Body, response))
+	if response.Success {
+		return true, ""
+	}
+	encoded, err := base64.StdEncoding.DecodeString(response.Result[0])
+	assert.NoError(t, err)
+	detail := &authorManagementProto.ErrorDetail{}
+	assert.NoError(t, proto.Unmarshal(encoded, detail))
+	return false, detail.Code.String()
+}
+
+func TestBrokerSession_Process(t *testing.T) {
+	useSettings(t)
+	unavailable := apperror.New(apperror.Unavailable, "database unavailable")
+	internal := apperror.New(apperror.Internal, "corrupted author")
+	tests := []struct {
+		name    string
+		event   *eventProto.Event
+		err     error
+		headers amqp.Table
+		failing map[string]bool
+		// replied Code of the error replied, empty when the reply succeeds.
+		replied string
+		noReply bool
+		retried bool
+		// deadLettered Attempts recorded on the dead lettered message, zero when not dead lettered.
+		deadLettered int64
+		acked        bool
+	}{
+		{name: "success", event: createEvent("John"), acked: true},
+		{name: "invalid event", event: createEvent(""), replied: "INVALID_ARGUMENT", acked: true},
+		{name: "unavailable is retried", event: createEvent("John"), err: unavailable, noReply: true,
+			retried: true, acked: true},
+		{name: "unavailable on the last attempt", event: createEvent("John"), err: unavailable,
+			headers: amqp.Table{broker.AttemptsHeader: int64(2)}, replied: "UNAVAILABLE", deadLettered: 3,
+			acked: true},
+		{name: "internal", event: createEvent("John"), err: internal, replied: "INTERNAL", deadLettered: 1,
+			acked: true},
+		{name: "retry not sent", event: createEvent("John"), err: unavailable,
+			failing: map[string]bool{"authorQueue.retry": true}, noReply: true},
+		{name: "dead letter not sent", event: createEvent("John"), err: internal,
+			failing: map[string]bool{"authorQueue.dead-letter": true}, noReply: true},
+		{name: "reply not sent", event: createEvent("John"), failing: map[string]bool{"": true}, noReply: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			memory := database.NewMemoryStore()
+			var store database.AuthorStore = memory
+			if test.err != nil {
+				store = &failingStore{MemoryStore: memory, err: test.err}
+			}
+			channel := &recordingChannel{failing: test.failing}
+			acknowledger := &recordingAcknowledger{}
+			message := newDelivery(test.event, acknowledger, test.headers)
+
+			newTestSession(store, memory).process(message, test.event, channel)
+
+			replies := channel.to("")
+			if test.noReply {
+				assert.Empty(t, replies)
+			} else if assert.Len(t, replies, 1) {
+				success, code := decodeReply(t, replies[0])
+				assert.Equal(t, test.replied == "", success)
+				assert.Equal(t, test.replied, code)
+				assert.Equal(t, message.CorrelationId, replies[0].CorrelationId)
+			}
+			retried := channel.to("authorQueue.retry")
+			if test.retried && assert.Len(t, retried, 1) {
+				assert.Equal(t, int64(1), retried[0].Headers[broker.AttemptsHeader])
+				assert.Equal(t, message.Body, retried[0].Body)
+				assert.Equal(t, message.MessageId, retried[0].MessageId)
+				assert.Equal(t, message.ReplyTo, retried[0].ReplyTo)
+			} else if !test.retried {
+				assert.Empty(t, retried)
+			}
+			deadLettered := channel.to("authorQueue.dead-letter")
+			if test.deadLettered > 0 && assert.Len(t, deadLettered, 1) {
+				assert.Equal(t, test.replied, deadLettered[0].Headers["x-error-code"])
+				assert.Equal(t, test.deadLettered, deadLettered[0].Headers[broker.AttemptsHeader])
+			} else if test.deadLettered == 0 {
+				assert.Empty(t, deadLettered)
+			}
+			if test.acked {
+				assert.Equal(t, 1, acknowledger.acks)
+			} else {
+				assert.Zero(t, acknowledger.acks, "messages that couldn't be answered are redelivered")
+			}
+			assert.Zero(t, acknowledger.nacks)
+		})
+	}
+}
+
+func TestBrokerSession_ProcessReplaysRedeliveries(t *testing.T) {
+	useSettings(t)
+	store := database.NewMemoryStore()
+	session := newTestSession(store, store)
+	channel := &recordingChannel{}
+	acknowledger := &recordingAcknowledger{}
+	event := createEvent("John")
+	message := newDelivery(event, acknowledger, nil)
+
+	session.process(message, event, channel)
+	session.process(message, event, channel)
+
+	replies := channel.to("")
+	if assert.Len(t, replies, 2) {
+		assert.Equal(t, replies[0].Body, replies[1].Body, "the redelivery gets the uuid of the created author")
+	}
+	assert.Equal(t, 2, acknowledger.acks)
+	page, err := store.ListAuthors(context.Background(), database.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, page.Authors, 1)
+}
+
+func TestBrokerSession_Consume(t *testing.T) {
+	useSettings(t)
+	tests := []struct {
+		name    string
+		failing map[string]bool
+		acked   bool
+	}{
+		{name: "undecodable message is dead lettered", acked: true},
+		{name: "dead letter not sent", failing: map[string]bool{"authorQueue.dead-letter": true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := database.NewMemoryStore()
+			channel := &recordingChannel{failing: test.failing}
+			acknowledger := &recordingAcknowledger{}
+			messages := make(chan amqp.Delivery, 2)
+			messages <- amqp.Delivery{Acknowledger: acknowledger, ReplyTo: "replies", Body: []byte("not an event")}
+			valid := &recordingAcknowledger{}
+			messages <- newDelivery(createEvent("John"), valid, nil)
+			close(messages)
+
+			newTestSession(store, store).consume(messages, channel)
+
+			assert.Equal(t, 1, valid.acks, "the following messages are processed")
+			deadLettered := channel.to("authorQueue.dead-letter")
+			if !test.acked {
+				assert.Zero(t, acknowledger.acks)
+				assert.Empty(t, deadLettered)
+				return
+			}
+			assert.Equal(t, 1, acknowledger.acks)
+			if assert.Len(t, deadLettered, 1) {
+				assert.Equal(t, "INVALID_ARGUMENT", deadLettered[0].Headers["x-error-code"])
+				assert.Equal(t, []byte("not an event"), deadLettered[0].Body)
+			}
+			replies := channel.to("")
+			if assert.Len(t, replies, 2) {
+				success, code := decodeReply(t, replies[0])
+				assert.False(t, success)
+				assert.Equal(t, "INVALID_ARGUMENT", code)
+			}
+		})
+	}
+}
+
+func TestBrokerSession_ConsumeBusyWorker(t *testing.T) {
+	useSettings(t)
+	settings.Prefetch = 4
+	memory := database.NewMemoryStore()
+	store := &blockingStore{MemoryStore: memory, release: make(chan struct{})}
+	messages := make(chan amqp.Delivery, 4)
+	// Creations are spread over the two workers in turns, so the slow one and Jane share a worker.
+	names := []string{"slow", "John", "Jane", "Mary"}
+	acknowledgers := make([]*recordingAcknowledger, len(names))
+	for i, name := range names {
+		acknowledgers[i] = &recordingAcknowledger{}
+		messages <- newDelivery(createEvent(name), acknowledgers[i], nil)
+	}
+	close(messages)
+	done := make(chan struct{})
+	go func() {
+		newTestSession(store, memory).consume(messages, &recordingChannel{})
+		close(done)
+	}()
+
+	acked := func(acknowledger *recordingAcknowledger) bool {
+		acknowledger.mutex.Lock()
+		defer acknowledger.mutex.Unlock()
+		return acknowledger.acks == 1
+	}
+	assert.Eventually(t, func() bool {
+		return acked(acknowledgers[3])
+	}, time.Second, time.Millisecond, "the messages queued behind the busy worker don't hold up the others")
+	assert.False(t, acked(acknowledgers[2]))
+	close(store.release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("consume didn't finish after the slow message was released")
+	}
+	for _, acknowledger := range acknowledgers {
+		assert.True(t, acked(acknowledger))
+	}
+}
+
+func TestBrokerSession_ProcessReusedCorrelationID(t *testing.T) {
+	useSettings(t)
+	store := database.NewMemoryStore()
+	session := newTestSession(store, store)
+	channel := &recordingChannel{}
+	acknowledger := &recordingAcknowledger{}
+	create := createEvent("John")
+	message := newDelivery(create, acknowledger, nil)
+	message.MessageId = ""
+	session.process(message, create, channel)
+	replies := channel.to("")
+	if !assert.Len(t, replies, 1) {
+		return
+	}
+	created := &eventProto.Response{}
+	assert.NoError(t, proto.Unmarshal(replies[0].Body, created))
+	id := created.Result[0]
+
+	update := &eventProto.Event{
+		Action:  eventProto.Action_UPDATE,
+		Message: utils.EncodeAuthorUpdateToString(&authorManagementProto.AuthorUpdate{Uuid: &id, Name: "Jane"}),
+	}
+	message = newDelivery(update, acknowledger, nil)
+	message.MessageId = ""
+	session.process(message, update, channel)
+
+	author, err := store.GetAuthor(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane", author.Name, "messages of a flow sharing the correlation id are all applied")
+	assert.Equal(t, 2, acknowledger.acks)
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel"
+)
+
+// HeadersCarrier Adapts the headers of an AMQP message to carry the trace context, as the
+// traceparent header.
+type HeadersCarrier amqp.Table
+
+// Get Returns the value of the header, empty when it is missing or isn't a string.
+func (carrier HeadersCarrier) Get(key string) string {
+	switch value := carrier[key].(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	}
+	return ""
+}
+
+// Set Sets the value of the header.
+func (carrier HeadersCarrier) Set(key string, value string) {
+	carrier[key] = value
+}
+
+// Keys Returns the names of the headers.
+func (carrier HeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Extract Returns ctx with the trace context carried by the headers of a received message.
+func Extract(ctx context.Context, headers amqp.Table) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, HeadersCarrier(headers))
+}
+
+// Inject Adds the trace context of ctx to the headers of a message being published, creating them
+// when nil.
+func Inject(ctx context.Context, headers amqp.Table) amqp.Table {
+	if headers == nil {
+		headers = amqp.Table{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, HeadersCarrier(headers))
+	return headers
+}
@@ -0,0 +1,13 @@
+package tracing
+
+import (
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
+	"gorm.io/gorm"
+)
+
+// NewGormPlugin Creates the gorm plugin tracing every query. The statements are recorded with
+// their placeholders instead of the bound values, which carry author data and stored replies.
+// Installed with gorm.DB.Use.
+func NewGormPlugin() gorm.Plugin {
+	return otelgorm.NewPlugin(otelgorm.WithoutQueryVariables())
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+)
+
+// ServiceName Name the spans of the service are reported with, unless OTEL_SERVICE_NAME is set.
+const ServiceName = "author-management-service"
+
+// endpointVariables Environment variables setting where the OTLP exporter sends the spans.
+var endpointVariables = []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"}
+
+// Shutdown Flushes the spans not exported yet and stops the exporter.
+type Shutdown func(ctx context.Context) error
+
+// Enabled Returns whether an OTLP endpoint is set on the environment and OTEL_SDK_DISABLED isn't
+// true.
+func Enabled() bool {
+	if os.Getenv("OTEL_SDK_DISABLED") == "true" {
+		return false
+	}
+	for _, variable := range endpointVariables {
+		if os.Getenv(variable) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Setup Installs the W3C trace context propagator and, when Enabled, a tracer provider exporting
+// the spans through OTLP over gRPC. The exporter is configured with the standard OTEL_EXPORTER_OTLP_*
+// environment variables, and the resource with OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES.
+// Otherwise spans aren't recorded and the returned Shutdown does nothing.
+func Setup(ctx context.Context) (Shutdown, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serviceResource, err := resource.Merge(
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(ServiceName)),
+		resource.Default(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(serviceResource),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
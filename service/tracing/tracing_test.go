@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestEnabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	assert.False(t, Enabled())
+
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "http://collector:4317")
+	assert.True(t, Enabled())
+
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	assert.False(t, Enabled())
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	shutdown, err := Setup(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+}
+
+func TestHeadersCarrier(t *testing.T) {
+	carrier := HeadersCarrier(amqp.Table{"traceparent": traceparent, "bytes": []byte("value"), "number": int32(1)})
+	assert.Equal(t, traceparent, carrier.Get("traceparent"))
+	assert.Equal(t, "value", carrier.Get("bytes"))
+	assert.Equal(t, "", carrier.Get("number"))
+	assert.Equal(t, "", carrier.Get("missing"))
+
+	carrier.Set("tracestate", "vendor=value")
+	assert.Equal(t, "vendor=value", carrier.Get("tracestate"))
+	assert.ElementsMatch(t, []string{"traceparent", "bytes", "number", "tracestate"}, carrier.Keys())
+}
+
+func TestExtractInject(t *testing.T) {
+	_, err := Setup(context.Background())
+	assert.NoError(t, err)
+
+	ctx := Extract(context.Background(), amqp.Table{"traceparent": traceparent})
+	spanContext := trace.SpanContextFromContext(ctx)
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+
+	headers := Inject(ctx, nil)
+	assert.Equal(t, traceparent, headers["traceparent"])
+
+	headers = Inject(context.Background(), amqp.Table{"x-actor": "editor"})
+	assert.Equal(t, amqp.Table{"x-actor": "editor"}, headers)
+}
+
+func TestGormPlugin(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.Use(NewGormPlugin()))
+	type author struct {
+		ID     string `gorm:"primaryKey"`
+		Name   string
+		PicURL string
+	}
+	assert.NoError(t, db.AutoMigrate(&author{}))
+	assert.NoError(t, db.Create(&author{ID: "a1b2c3", Name: "Jose Saramago", PicURL: "https://example.com/jose.png"}).Error)
+	var found author
+	assert.NoError(t, db.Where("name = ?", "Jose Saramago").First(&found).Error)
+
+	statements := 0
+	for _, span := range spans.Ended() {
+		for _, attr := range span.Attributes() {
+			if attr.Key != semconv.DBStatementKey {
+				continue
+			}
+			statements++
+			statement := attr.Value.AsString()
+			for _, value := range []string{"a1b2c3", "Jose Saramago", "https://example.com/jose.png"} {
+				assert.NotContains(t, statement, value)
+			}
+		}
+	}
+	assert.GreaterOrEqual(t, statements, 2)
+}
@@ -1,14 +1,48 @@
 package utils
 
-import eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
+import (
+	"service/apperror"
 
-// BuildResponse Receives an array of string and an error and parse them into a Response.
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
+)
+
+// errorCodes Maps the service error codes into the codes sent on the ErrorDetail.
+var errorCodes = map[apperror.Code]authorManagementProto.ErrorDetail_Code{
+	apperror.Internal:        authorManagementProto.ErrorDetail_INTERNAL,
+	apperror.NotFound:        authorManagementProto.ErrorDetail_NOT_FOUND,
+	apperror.AlreadyExists:   authorManagementProto.ErrorDetail_ALREADY_EXISTS,
+	apperror.InvalidArgument: authorManagementProto.ErrorDetail_INVALID_ARGUMENT,
+	apperror.Unavailable:     authorManagementProto.ErrorDetail_UNAVAILABLE,
+	apperror.Conflict:        authorManagementProto.ErrorDetail_CONFLICT,
+}
+
+// BuildErrorDetail Transforms an error into the ErrorDetail sent to clients.
+func BuildErrorDetail(err error) *authorManagementProto.ErrorDetail {
+	var violations []*authorManagementProto.FieldViolation
+	for _, violation := range apperror.ViolationsOf(err) {
+		violations = append(violations, &authorManagementProto.FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	return &authorManagementProto.ErrorDetail{
+		Code:       errorCodes[apperror.CodeOf(err)],
+		Message:    apperror.MessageOf(err),
+		Violations: violations,
+	}
+}
+
+// BuildResponse Receives an array of string and an error and parse them into a Response. Failed
+// responses carry the human readable message on Error and the base64 serialized ErrorDetail as
+// the only Result.
 func BuildResponse(message []string, err error) *eventProto.Response {
 	if err != nil {
-		errorString := err.Error()
+		detail := BuildErrorDetail(err)
 		return &eventProto.Response{
 			Success: false,
-			Error:   &errorString,
+			Error:   &detail.Message,
+			Result:  []string{EncodeErrorDetailToString(detail)},
 		}
 	}
 	return &eventProto.Response{
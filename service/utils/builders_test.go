@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"encoding/base64"
 	"errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
+	"service/apperror"
 	"testing"
 )
 
@@ -20,3 +24,33 @@ func TestBuildResponseCorrectValues(t *testing.T) {
 	response := BuildResponse([]string{expectedMessage}, nil)
 	assert.Equal(t, response.Result[0], expectedMessage)
 }
+
+func TestBuildResponseWithTypedError(t *testing.T) {
+	err := apperror.Wrap(apperror.Unavailable, "database unavailable", errors.New("connection refused"))
+	response := BuildResponse(nil, err)
+	assert.False(t, response.Success)
+	assert.Equal(t, "database unavailable", response.GetError())
+	assert.Len(t, response.Result, 1)
+	decoded, _ := base64.StdEncoding.DecodeString(response.Result[0])
+	detail := &authorManagementProto.ErrorDetail{}
+	assert.NoError(t, proto.Unmarshal(decoded, detail))
+	assert.Equal(t, authorManagementProto.ErrorDetail_UNAVAILABLE, detail.Code)
+	assert.Equal(t, "database unavailable", detail.Message)
+}
+
+func TestBuildErrorDetailWithUntypedError(t *testing.T) {
+	detail := BuildErrorDetail(errors.New("expected error"))
+	assert.Equal(t, authorManagementProto.ErrorDetail_INTERNAL, detail.Code)
+	assert.Equal(t, "expected error", detail.Message)
+}
+
+func TestBuildErrorDetailWithViolations(t *testing.T) {
+	err := apperror.Invalid("invalid author", []apperror.FieldViolation{
+		{Field: "name", Description: "name is required"},
+	})
+	detail := BuildErrorDetail(err)
+	assert.Equal(t, authorManagementProto.ErrorDetail_INVALID_ARGUMENT, detail.Code)
+	assert.Len(t, detail.Violations, 1)
+	assert.Equal(t, "name", detail.Violations[0].Field)
+	assert.Equal(t, "name is required", detail.Violations[0].Description)
+}
@@ -2,30 +2,76 @@ package utils
 
 import (
 	"encoding/base64"
+	"service/apperror"
+
 	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
 	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	// ErrEmptyEvent Returned when the body received from the message broker is empty.
+	ErrEmptyEvent = apperror.New(apperror.InvalidArgument, "empty event")
+	// ErrEmptyAuthor Returned when an event that needs an author carries an empty message.
+	ErrEmptyAuthor = apperror.New(apperror.InvalidArgument, "empty author message")
+)
+
 // DecodeEvent Receives an array of bytes and transform to proto Event.
-func DecodeEvent(body []byte) *eventProto.Event {
+func DecodeEvent(body []byte) (*eventProto.Event, error) {
+	if len(body) == 0 {
+		return nil, ErrEmptyEvent
+	}
 	event := &eventProto.Event{}
-	proto.Unmarshal(body, event)
-	return event
+	if err := proto.Unmarshal(body, event); err != nil {
+		return nil, apperror.Wrap(apperror.InvalidArgument, "invalid event", err)
+	}
+	return event, nil
 }
 
-// DecodeQuery Receives a base64 serialized string and parse it to a proto Query.
-func DecodeQuery(message string) *eventProto.Query {
-	decoded, _ := base64.StdEncoding.DecodeString(message)
-	query := &eventProto.Query{}
-	proto.Unmarshal(decoded, query)
-	return query
+// decodeMessage Decodes a base64 serialized string into the passed proto message.
+func decodeMessage(message string, decodedMessage proto.Message) error {
+	decoded, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return apperror.Wrap(apperror.InvalidArgument, "message is not valid base64", err)
+	}
+	if err := proto.Unmarshal(decoded, decodedMessage); err != nil {
+		name := decodedMessage.ProtoReflect().Descriptor().Name()
+		return apperror.Wrap(apperror.InvalidArgument, "invalid "+string(name)+" message", err)
+	}
+	return nil
 }
 
 // DecodeAuthor Receives a base64 serialized string and parse it to a proto Author.
-func DecodeAuthor(message string) *authorManagementProto.Author {
-	decoded, _ := base64.StdEncoding.DecodeString(message)
+func DecodeAuthor(message string) (*authorManagementProto.Author, error) {
+	if message == "" {
+		return nil, ErrEmptyAuthor
+	}
 	author := &authorManagementProto.Author{}
-	proto.Unmarshal(decoded, author)
-	return author
+	if err := decodeMessage(message, author); err != nil {
+		return nil, err
+	}
+	return author, nil
+}
+
+// DecodeAuthorUpdate Receives a base64 serialized string and parse it to a proto AuthorUpdate.
+// Since AuthorUpdate is wire compatible with Author, both messages can be decoded by it.
+func DecodeAuthorUpdate(message string) (*authorManagementProto.AuthorUpdate, error) {
+	if message == "" {
+		return nil, ErrEmptyAuthor
+	}
+	update := &authorManagementProto.AuthorUpdate{}
+	if err := decodeMessage(message, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// DecodeAuthorQuery Receives a base64 serialized string and parse it to a proto AuthorQuery.
+// Since AuthorQuery is wire compatible with Query, both messages can be decoded by it.
+func DecodeAuthorQuery(message string) (*authorManagementProto.AuthorQuery, error) {
+	query := &authorManagementProto.AuthorQuery{}
+	if err := decodeMessage(message, query); err != nil {
+		return nil, err
+	}
+	return query, nil
 }
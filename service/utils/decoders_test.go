@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	authorManagementProto "github.com/wcodesoft/author-management-service/protos/go/author-management.proto"
 	eventProto "github.com/wcodesoft/event-manager/protos/go/event-manager.proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"service/apperror"
 	"testing"
 )
 
@@ -16,24 +18,12 @@ func TestDecodeEvent(t *testing.T) {
 		Message: "EventProto",
 	}
 	encoded, _ := proto.Marshal(expectedEvent)
-	decoded := DecodeEvent(encoded)
+	decoded, err := DecodeEvent(encoded)
+	assert.NoError(t, err)
 	assert.Equal(t, expectedEvent.Action, decoded.Action)
 	assert.Equal(t, expectedEvent.Message, decoded.Message)
 }
 
-func TestDecodeQuery(t *testing.T) {
-	uuidString := uuid.NewString()
-	expectedQuery := &eventProto.Query{
-		AllEntries: false,
-		Uuid:       &uuidString,
-	}
-	encoded, _ := proto.Marshal(expectedQuery)
-	queryString := base64.StdEncoding.EncodeToString(encoded)
-	decodedQuery := DecodeQuery(queryString)
-	assert.Equal(t, expectedQuery.Uuid, decodedQuery.Uuid)
-	assert.Equal(t, expectedQuery.AllEntries, decodedQuery.AllEntries)
-}
-
 func TestDecodeAuthor(t *testing.T) {
 	uuidString := uuid.NewString()
 	expectedAuthor := &authorManagementProto.Author{
@@ -42,8 +32,91 @@ func TestDecodeAuthor(t *testing.T) {
 	}
 	encoded, _ := proto.Marshal(expectedAuthor)
 	authorString := base64.StdEncoding.EncodeToString(encoded)
-	decodedAuthor := DecodeAuthor(authorString)
+	decodedAuthor, err := DecodeAuthor(authorString)
+	assert.NoError(t, err)
 	assert.Equal(t, expectedAuthor.Name, decodedAuthor.Name)
 	assert.Equal(t, expectedAuthor.Uuid, decodedAuthor.Uuid)
 	assert.Equal(t, expectedAuthor.PicUrl, decodedAuthor.PicUrl)
 }
+
+func TestDecodeAuthorUpdate(t *testing.T) {
+	uuidString := uuid.NewString()
+	expectedUpdate := &authorManagementProto.AuthorUpdate{
+		Uuid:       &uuidString,
+		Name:       "John Doe",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "picUrl"}},
+	}
+	decodedUpdate, err := DecodeAuthorUpdate(EncodeAuthorUpdateToString(expectedUpdate))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedUpdate.Uuid, decodedUpdate.Uuid)
+	assert.Equal(t, expectedUpdate.Name, decodedUpdate.Name)
+	assert.Equal(t, expectedUpdate.UpdateMask.Paths, decodedUpdate.UpdateMask.Paths)
+}
+
+func TestDecodeAuthorUpdateFromAuthor(t *testing.T) {
+	uuidString := uuid.NewString()
+	picURL := "https://example.com/johndoe.png"
+	author := &authorManagementProto.Author{
+		Uuid:   &uuidString,
+		Name:   "John Doe",
+		PicUrl: &picURL,
+	}
+	decodedUpdate, err := DecodeAuthorUpdate(EncodeAuthorToString(author))
+	assert.NoError(t, err)
+	assert.Equal(t, author.Uuid, decodedUpdate.Uuid)
+	assert.Equal(t, author.Name, decodedUpdate.Name)
+	assert.Equal(t, author.PicUrl, decodedUpdate.PicUrl)
+	assert.Nil(t, decodedUpdate.UpdateMask)
+
+	_, err = DecodeAuthorUpdate("")
+	assert.ErrorIs(t, err, ErrEmptyAuthor)
+}
+
+func TestDecodeAuthorQuery(t *testing.T) {
+	expectedQuery := &authorManagementProto.AuthorQuery{
+		AllEntries: true,
+		PageSize:   10,
+		PageToken:  "token",
+		NamePrefix: "John",
+	}
+	encoded, _ := proto.Marshal(expectedQuery)
+	decodedQuery, err := DecodeAuthorQuery(base64.StdEncoding.EncodeToString(encoded))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedQuery.AllEntries, decodedQuery.AllEntries)
+	assert.Equal(t, expectedQuery.PageSize, decodedQuery.PageSize)
+	assert.Equal(t, expectedQuery.PageToken, decodedQuery.PageToken)
+	assert.Equal(t, expectedQuery.NamePrefix, decodedQuery.NamePrefix)
+}
+
+func TestDecodeAuthorQueryFromQuery(t *testing.T) {
+	uuidString := uuid.NewString()
+	query := &eventProto.Query{
+		AllEntries: false,
+		Uuid:       &uuidString,
+	}
+	encoded, _ := proto.Marshal(query)
+	decodedQuery, err := DecodeAuthorQuery(base64.StdEncoding.EncodeToString(encoded))
+	assert.NoError(t, err)
+	assert.Equal(t, query.Uuid, decodedQuery.Uuid)
+	assert.False(t, decodedQuery.AllEntries)
+}
+
+func TestDecodeEventWithInvalidBody(t *testing.T) {
+	_, err := DecodeEvent([]byte{0xff, 0xff, 0xff})
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	_, err = DecodeEvent(nil)
+	assert.ErrorIs(t, err, ErrEmptyEvent)
+}
+
+func TestDecodeAuthorWithInvalidMessage(t *testing.T) {
+	_, err := DecodeAuthor(base64.StdEncoding.EncodeToString([]byte{0xff, 0xff, 0xff}))
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	assert.Equal(t, "invalid Author message", apperror.MessageOf(err))
+	_, err = DecodeAuthor("")
+	assert.ErrorIs(t, err, ErrEmptyAuthor)
+}
+
+func TestDecodeAuthorQueryWithInvalidBase64(t *testing.T) {
+	_, err := DecodeAuthorQuery("not base64!")
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+}
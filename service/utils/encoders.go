@@ -13,6 +13,12 @@ func EncodeResponseToByte(response *eventManager.Response) []byte {
 	return encoded
 }
 
+// EncodeAuthorChangedToByte Encodes the proto AuthorChanged into a byte array.
+func EncodeAuthorChangedToByte(change *authorManagementProto.AuthorChanged) []byte {
+	encoded, _ := proto.Marshal(change)
+	return encoded
+}
+
 // EncodeAuthorToString Encodes the proto Author into a base64 serialized string.
 func EncodeAuthorToString(author *authorManagementProto.Author) string {
 	encoded, _ := proto.Marshal(author)
@@ -20,9 +26,30 @@ func EncodeAuthorToString(author *authorManagementProto.Author) string {
 	return encodedString
 }
 
+// EncodeAuthorUpdateToString Encodes the proto AuthorUpdate into a base64 serialized string.
+func EncodeAuthorUpdateToString(update *authorManagementProto.AuthorUpdate) string {
+	encoded, _ := proto.Marshal(update)
+	encodedString := base64.StdEncoding.EncodeToString(encoded)
+	return encodedString
+}
+
 // EncodeAuthorsListToString Encodes the proto AuthorList into a base64 serialized string.
 func EncodeAuthorsListToString(list *authorManagementProto.AuthorList) string {
 	encoded, _ := proto.Marshal(list)
 	encodedString := base64.StdEncoding.EncodeToString(encoded)
 	return encodedString
 }
+
+// EncodeAuthorHistoryToString Encodes the proto AuthorHistory into a base64 serialized string.
+func EncodeAuthorHistoryToString(history *authorManagementProto.AuthorHistory) string {
+	encoded, _ := proto.Marshal(history)
+	encodedString := base64.StdEncoding.EncodeToString(encoded)
+	return encodedString
+}
+
+// EncodeErrorDetailToString Encodes the proto ErrorDetail into a base64 serialized string.
+func EncodeErrorDetailToString(detail *authorManagementProto.ErrorDetail) string {
+	encoded, _ := proto.Marshal(detail)
+	encodedString := base64.StdEncoding.EncodeToString(encoded)
+	return encodedString
+}
@@ -52,3 +52,13 @@ func TestEncodeAuthorsListToString(t *testing.T) {
 	resultString := EncodeAuthorsListToString(authorsList)
 	assert.Equal(t, expectedBase64, resultString)
 }
+
+func TestEncodeErrorDetailToString(t *testing.T) {
+	detail := &authorManagementProto.ErrorDetail{
+		Code:    authorManagementProto.ErrorDetail_NOT_FOUND,
+		Message: "author not found",
+	}
+	encoded, _ := proto.Marshal(detail)
+	expectedBase64 := base64.StdEncoding.EncodeToString(encoded)
+	assert.Equal(t, expectedBase64, EncodeErrorDetailToString(detail))
+}
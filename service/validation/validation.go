@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"service/apperror"
+	"service/database"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// DefaultMaxNameLength Maximum number of characters on an author name by default.
+	DefaultMaxNameLength = 200
+	// DefaultMaxPicURLLength Maximum number of characters on an author picUrl by default.
+	DefaultMaxPicURLLength = 2048
+)
+
+// Config Rules applied when validating authors.
+type Config struct {
+	// MaxNameLength Maximum number of characters on the name.
+	MaxNameLength int
+	// MaxPicURLLength Maximum number of characters on the picUrl.
+	MaxPicURLLength int
+	// AllowedPicHosts Hosts the picUrl may point to. Entries starting with a dot also allow any
+	// subdomain. When empty every host is allowed.
+	AllowedPicHosts []string
+}
+
+// DefaultConfig Returns the Config used when none is set.
+func DefaultConfig() Config {
+	return Config{
+		MaxNameLength:   DefaultMaxNameLength,
+		MaxPicURLLength: DefaultMaxPicURLLength,
+	}
+}
+
+// Validator Normalizes and validates authors before they are stored.
+type Validator struct {
+	config Config
+}
+
+// NewValidator Creates a new Validator applying the rules of the passed Config.
+func NewValidator(config Config) *Validator {
+	return &Validator{
+		config: config,
+	}
+}
+
+// normalize Trims the value and converts it to the Unicode NFC form, so the same text is always
+// stored with the same characters.
+func normalize(value string) string {
+	return strings.TrimSpace(norm.NFC.String(value))
+}
+
+// Validate Normalizes the author fields in place and checks them against the rules. The name is
+// required when nameRequired is set, as on creations or updates overwriting it, while other
+// updates may leave it empty to keep the stored one. Returns an InvalidArgument error listing
+// every field that is not valid.
+func (validator *Validator) Validate(author *database.Author, nameRequired bool) error {
+	var violations []apperror.FieldViolation
+	author.Name = normalize(author.Name)
+	if violation := validator.validateName(author.Name, nameRequired); violation != "" {
+		violations = append(violations, apperror.FieldViolation{Field: "name", Description: violation})
+	}
+	if author.PicURL != nil {
+		picURL := normalize(*author.PicURL)
+		author.PicURL = &picURL
+		if violation := validator.validatePicURL(picURL); violation != "" {
+			violations = append(violations, apperror.FieldViolation{Field: "picUrl", Description: violation})
+		}
+	}
+	if len(violations) > 0 {
+		return apperror.Invalid("invalid author", violations)
+	}
+	return nil
+}
+
+// validateName Returns why the name is not valid, or an empty string when it is.
+func (validator *Validator) validateName(name string, required bool) string {
+	if name == "" {
+		if required {
+			return "name is required"
+		}
+		return ""
+	}
+	if !utf8.ValidString(name) {
+		return "name must be valid UTF-8"
+	}
+	if utf8.RuneCountInString(name) > validator.config.MaxNameLength {
+		return fmt.Sprintf("name must have at most %d characters", validator.config.MaxNameLength)
+	}
+	return ""
+}
+
+// validatePicURL Returns why the picUrl is not valid, or an empty string when it is. An empty
+// picUrl is valid and clears the stored one.
+func (validator *Validator) validatePicURL(picURL string) string {
+	if picURL == "" {
+		return ""
+	}
+	if utf8.RuneCountInString(picURL) > validator.config.MaxPicURLLength {
+		return fmt.Sprintf("picUrl must have at most %d characters", validator.config.MaxPicURLLength)
+	}
+	parsed, err := url.Parse(picURL)
+	if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+		return "picUrl must be an absolute URL"
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "picUrl must use http or https"
+	}
+	if !validator.hostAllowed(parsed.Hostname()) {
+		return fmt.Sprintf("picUrl host %s is not allowed", parsed.Hostname())
+	}
+	return ""
+}
+
+// hostAllowed Checks if the host is on the allowed list.
+func (validator *Validator) hostAllowed(host string) bool {
+	if len(validator.config.AllowedPicHosts) == 0 {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, allowed := range validator.config.AllowedPicHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"github.com/stretchr/testify/assert"
+	"service/apperror"
+	"service/database"
+	"strings"
+	"testing"
+)
+
+func TestValidateNormalizesFields(t *testing.T) {
+	validator := NewValidator(DefaultConfig())
+	// "Jose" followed by a combining acute accent, which NFC composes into a single character.
+	picURL := "  https://example.com/jose.png "
+	author := database.Author{Name: "  Jose\u0301 ", PicURL: &picURL}
+	err := validator.Validate(&author, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jos\u00e9", author.Name)
+	assert.Equal(t, "https://example.com/jose.png", *author.PicURL)
+}
+
+func TestValidateRequiresNameOnCreate(t *testing.T) {
+	validator := NewValidator(DefaultConfig())
+	err := validator.Validate(&database.Author{Name: "   "}, true)
+	assert.Equal(t, apperror.InvalidArgument, apperror.CodeOf(err))
+	assert.Equal(t, []apperror.FieldViolation{{Field: "name", Description: "name is required"}},
+		apperror.ViolationsOf(err))
+	assert.NoError(t, validator.Validate(&database.Author{}, false))
+}
+
+func TestValidateNameLength(t *testing.T) {
+	validator := NewValidator(Config{MaxNameLength: 5, MaxPicURLLength: DefaultMaxPicURLLength})
+	assert.NoError(t, validator.Validate(&database.Author{Name: "Josée"}, true))
+	err := validator.Validate(&database.Author{Name: "John Doe"}, true)
+	assert.Equal(t, "name", apperror.ViolationsOf(err)[0].Field)
+}
+
+func TestValidatePicURL(t *testing.T) {
+	validator := NewValidator(DefaultConfig())
+	invalid := []string{
+		"johndoe",
+		"/relative/path.png",
+		"ftp://example.com/pic.png",
+		"https://" + strings.Repeat("a", DefaultMaxPicURLLength) + ".com",
+	}
+	for _, picURL := range invalid {
+		value := picURL
+		err := validator.Validate(&database.Author{Name: "John Doe", PicURL: &value}, true)
+		violations := apperror.ViolationsOf(err)
+		assert.Len(t, violations, 1, "picUrl %s", picURL)
+		assert.Equal(t, "picUrl", violations[0].Field)
+	}
+	empty := ""
+	assert.NoError(t, validator.Validate(&database.Author{Name: "John Doe", PicURL: &empty}, true))
+}
+
+func TestValidateAllowedPicHosts(t *testing.T) {
+	config := DefaultConfig()
+	config.AllowedPicHosts = []string{"cdn.example.com", ".images.example.org"}
+	validator := NewValidator(config)
+	allowed := []string{
+		"https://cdn.example.com/pic.png",
+		"https://CDN.example.com:8080/pic.png",
+		"http://eu.images.example.org/pic.png",
+	}
+	for _, picURL := range allowed {
+		value := picURL
+		assert.NoError(t, validator.Validate(&database.Author{Name: "John Doe", PicURL: &value}, true), picURL)
+	}
+	picURL := "https://example.com/pic.png"
+	err := validator.Validate(&database.Author{Name: "John Doe", PicURL: &picURL}, true)
+	assert.Equal(t, "picUrl host example.com is not allowed", apperror.ViolationsOf(err)[0].Description)
+}
+
+func TestValidateReportsEveryField(t *testing.T) {
+	validator := NewValidator(DefaultConfig())
+	picURL := "johndoe"
+	err := validator.Validate(&database.Author{PicURL: &picURL}, true)
+	assert.Len(t, apperror.ViolationsOf(err), 2)
+}
@@ -0,0 +1,61 @@
+package workers
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// Pool Fixed number of workers running tasks concurrently. Tasks submitted with the same key run
+// on the same worker, in the order they were submitted.
+type Pool struct {
+	queues    []chan func()
+	next      int
+	waitGroup sync.WaitGroup
+}
+
+// NewPool Creates a new Pool with size workers, each queueing up to queueLength tasks before
+// Submit blocks. The workers run until Close is called.
+func NewPool(size int, queueLength int) *Pool {
+	if size < 1 {
+		size = 1
+	}
+	pool := &Pool{queues: make([]chan func(), size)}
+	for i := range pool.queues {
+		queue := make(chan func(), queueLength)
+		pool.queues[i] = queue
+		pool.waitGroup.Add(1)
+		go func() {
+			defer pool.waitGroup.Done()
+			for task := range queue {
+				task()
+			}
+		}()
+	}
+	return pool
+}
+
+// Submit Queues the task on the worker of the key, blocking while its queue is full. Tasks
+// without a key are spread over the workers in turns. Must not be called concurrently nor after
+// Close.
+func (pool *Pool) Submit(key string, task func()) {
+	pool.queues[pool.worker(key)] <- task
+}
+
+// worker Returns the index of the worker running the tasks of the key.
+func (pool *Pool) worker(key string) int {
+	if key == "" {
+		pool.next = (pool.next + 1) % len(pool.queues)
+		return pool.next
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(len(pool.queues)))
+}
+
+// Close Stops accepting tasks and waits until the queued ones finished.
+func (pool *Pool) Close() {
+	for _, queue := range pool.queues {
+		close(queue)
+	}
+	pool.waitGroup.Wait()
+}
@@ -0,0 +1,64 @@
+package workers
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool_KeepsOrderPerKey(t *testing.T) {
+	pool := NewPool(4, 1)
+	var mutex sync.Mutex
+	processed := map[string][]int{}
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("author-%d", i%5)
+		i := i
+		pool.Submit(key, func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			processed[key] = append(processed[key], i)
+		})
+	}
+	pool.Close()
+
+	assert.Len(t, processed, 5)
+	for _, order := range processed {
+		assert.Len(t, order, 20)
+		for i := 1; i < len(order); i++ {
+			assert.Greater(t, order[i], order[i-1])
+		}
+	}
+}
+
+func TestPool_RunsConcurrently(t *testing.T) {
+	pool := NewPool(2, 0)
+	var running int32
+	release := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		pool.Submit("", func() {
+			atomic.AddInt32(&running, 1)
+			<-release
+		})
+	}
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&running) == 2
+	}, time.Second, time.Millisecond, "tasks without a key run on different workers")
+	close(release)
+	pool.Close()
+}
+
+func TestPool_CloseWaitsForTasks(t *testing.T) {
+	pool := NewPool(1, 10)
+	var done int32
+	for i := 0; i < 10; i++ {
+		pool.Submit("author", func() {
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&done, 1)
+		})
+	}
+	pool.Close()
+	assert.Equal(t, int32(10), atomic.LoadInt32(&done))
+}